@@ -7,8 +7,20 @@ import (
 )
 
 type Render struct {
-	closer *Closer
-	api    *vlk.VLK
+	closer    *Closer
+	api       *vlk.VLK
+	cameras   [256]Camera2D // camera for each surface
+	blendMode BlendMode     // blend mode for all next drawings
+	debugText debugText     // embedded font for Draw2dDebugText
+
+	pointSizeMax float32 // max point sprite size (px), supported by GPU
+
+	transform  transform2d   // current transform of all next 2d drawings
+	transforms []transform2d // transform stack (see PushTransform)
+	clips      []surfaceBox  // clip stack in surface pixels (see PushClipRect)
+
+	maskMode vlk.MaskMode // mask writing/testing of all next drawings (see BeginMask)
+	maskUsed bool         // mask was written on main surface in current frame
 }
 
 func NewRender(wm vlkext.WindowManager, cfg *config.Config) *Render {
@@ -20,9 +32,13 @@ func NewRender(wm vlkext.WindowManager, cfg *config.Config) *Render {
 	renderer.WarmUp()
 	renderer.GPUWait()
 
+	_, pointSizeMax := renderer.PointSizeRange()
+
 	api := &Render{
-		closer: closer,
-		api:    renderer,
+		closer:       closer,
+		api:          renderer,
+		pointSizeMax: pointSizeMax,
+		transform:    transform2dIdentity,
 	}
 
 	registerStdShaders(api)
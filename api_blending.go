@@ -0,0 +1,49 @@
+package vgl
+
+import (
+	"github.com/go-glx/vgl/internal/gpu/vlk"
+)
+
+const (
+	BlendModeDefault       BlendMode = iota // default alpha blending: src * src.a + dst * (1 - src.a)
+	BlendModeAdditive                       // src * src.a + dst. Good for particles, light, fire
+	BlendModeMultiply                       // src * dst + dst * (1 - src.a). Good for shadows and tinting (best with opaque colors)
+	BlendModeScreen                         // src * (1 - dst) + dst. Brighten surface, opposite of multiply
+	BlendModePremultiplied                  // src + dst * (1 - src.a). For colors/textures with already premultiplied alpha
+	BlendModeReplace                        // src. Blending is turned off, pixels (and alpha) are overwritten
+)
+
+// BlendMode define how drawing pixels is mixed with
+// pixels already drawn on surface
+type BlendMode uint8
+
+// SetBlendMode will change blend mode for all next drawings
+// mode is persisted between frames, until changed again
+//
+// Every mode change will break draw batching, so good idea is to
+// group drawings with same blend mode together
+func (r *Render) SetBlendMode(mode BlendMode) {
+	r.blendMode = mode
+}
+
+// BlendMode returns current blend mode
+func (r *Render) BlendMode() BlendMode {
+	return r.blendMode
+}
+
+func (m BlendMode) toVLK() vlk.BlendMode {
+	switch m {
+	case BlendModeAdditive:
+		return vlk.BlendModeAdditive
+	case BlendModeMultiply:
+		return vlk.BlendModeMultiply
+	case BlendModeScreen:
+		return vlk.BlendModeScreen
+	case BlendModePremultiplied:
+		return vlk.BlendModePremultiplied
+	case BlendModeReplace:
+		return vlk.BlendModeReplace
+	default:
+		return vlk.BlendModeAlpha
+	}
+}
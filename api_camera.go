@@ -0,0 +1,36 @@
+package vgl
+
+import (
+	"github.com/go-glx/glx"
+)
+
+// SetCamera2D will set camera for current surface
+// all next drawings on this surface will be transformed
+// with this camera (on GPU side), until camera is changed again
+//
+// Camera can be changed many times in one frame, for example
+// for drawing world with scrolling camera, and then UI with
+// default camera (Camera2D{})
+func (r *Render) SetCamera2D(camera Camera2D) {
+	r.cameras[r.api.CurrentSurface()] = camera
+	r.api.SetSurfaceView(camera.viewMatrix())
+}
+
+// Camera2D returns current camera of current surface
+func (r *Render) Camera2D() Camera2D {
+	return r.cameras[r.api.CurrentSurface()]
+}
+
+// ScreenToWorld will transform surface pixel position (for example mouse
+// position in window) into world position with current surface camera
+func (r *Render) ScreenToWorld(pos glx.Vec2) glx.Vec2 {
+	camera := r.Camera2D()
+	return camera.screenToWorld(pos)
+}
+
+// WorldToScreen will transform world position into surface
+// pixel position with current surface camera
+func (r *Render) WorldToScreen(pos glx.Vec2) glx.Vec2 {
+	camera := r.Camera2D()
+	return camera.worldToScreen(pos)
+}
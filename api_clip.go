@@ -0,0 +1,61 @@
+package vgl
+
+import (
+	"github.com/go-glx/glx"
+)
+
+// Rect2D is axis aligned rect
+type Rect2D struct {
+	Pos  glx.Vec2 // top,left corner in pixels
+	Size glx.Vec2 // width and height in pixels
+}
+
+// PushClipRect will restrict all next 2d drawings to rect area, until
+// PopClipRect. Rect is in local space of current transform and camera,
+// when rect is rotated, it bounding box is used (clip is always axis
+// aligned on surface)
+//
+// Clips is nested: new clip is intersection with previous one, so
+// drawing inside scroll view inside panel is clipped by both.
+// Instances fully outside of clip is culled and not send to GPU
+//
+// Every clip change will start new draw group, so group many drawings
+// in one clip for better batching
+//
+// Clip stack is reset on FrameStart
+func (r *Render) PushClipRect(rect Rect2D) {
+	clip := newClipBox([4]glx.Vec2{
+		r.localToScreen(rect.Pos),
+		r.localToScreen(glx.Vec2{X: rect.Pos.X + rect.Size.X, Y: rect.Pos.Y}),
+		r.localToScreen(rect.Pos.Add(rect.Size)),
+		r.localToScreen(glx.Vec2{X: rect.Pos.X, Y: rect.Pos.Y + rect.Size.Y}),
+	})
+
+	if len(r.clips) > 0 {
+		clip = clip.intersect(r.clips[len(r.clips)-1])
+	}
+
+	r.clips = append(r.clips, clip)
+}
+
+// PopClipRect will restore clip, that was before last PushClipRect
+func (r *Render) PopClipRect() {
+	if len(r.clips) == 0 {
+		return
+	}
+
+	r.clips = r.clips[:len(r.clips)-1]
+}
+
+// currentClip returns active clip in surface pixels
+func (r *Render) currentClip() (surfaceBox, bool) {
+	if len(r.clips) == 0 {
+		return surfaceBox{}, false
+	}
+
+	return r.clips[len(r.clips)-1], true
+}
+
+func (r *Render) resetClip() {
+	r.clips = r.clips[:0]
+}
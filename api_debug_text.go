@@ -0,0 +1,73 @@
+package vgl
+
+import (
+	"fmt"
+
+	"github.com/go-glx/glx"
+	"github.com/go-glx/vgl/internal/fonts"
+)
+
+type debugText struct {
+	font   *Font
+	failed bool // font loading failed, will not try again
+	scale  int
+}
+
+// SetDebugTextScale will change integer scale of Draw2dDebugText
+// font for all next drawings. Default scale is 1 (7x13 px glyphs)
+func (r *Render) SetDebugTextScale(scale int) {
+	if scale < 1 {
+		scale = 1
+	}
+
+	r.debugText.scale = scale
+}
+
+// Draw2dDebugText will draw text with embedded monospace font on current
+// surface with current blend mode. Text is formatted like fmt.Sprintf.
+// Position is rounded to whole pixels, so glyphs are always pixel-perfect
+// (with default camera)
+//
+// Font is uploaded to GPU on first call, and then can be used
+// for editor overlays, gizmos and frame stats without any assets
+func (r *Render) Draw2dDebugText(pos glx.Vec2, color glx.Color, format string, args ...any) {
+	font := r.debugFont()
+	if font == nil {
+		return
+	}
+
+	text := format
+	if len(args) > 0 {
+		text = fmt.Sprintf(format, args...)
+	}
+
+	scale := r.debugText.scale
+	if scale < 1 {
+		scale = 1
+	}
+
+	r.Draw2dText(&Params2dText{
+		Font:  font,
+		Text:  text,
+		Pos:   glx.Vec2{X: glx.Floor(pos.X), Y: glx.Floor(pos.Y)},
+		Color: color,
+		Size:  font.Size() * float32(scale),
+	})
+}
+
+func (r *Render) debugFont() *Font {
+	if r.debugText.font != nil || r.debugText.failed {
+		return r.debugText.font
+	}
+
+	// nearest filter keep glyphs pixel-perfect with integer
+	// scale, and with cameras, that scale whole surface
+	font, err := r.loadFont(fonts.Debug, fonts.DebugFontFile, TextureFilterNearest)
+	if err != nil {
+		r.debugText.failed = true
+		return nil
+	}
+
+	r.debugText.font = font
+	return font
+}
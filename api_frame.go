@@ -6,6 +6,9 @@ import (
 
 // FrameStart should be called before any drawing in current frame
 func (r *Render) FrameStart() {
+	r.resetTransform()
+	r.resetClip()
+	r.resetMask()
 	r.api.FrameStart()
 }
 
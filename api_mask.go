@@ -0,0 +1,108 @@
+package vgl
+
+import (
+	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/glx"
+	"github.com/go-glx/vgl/internal/gpu/vlk"
+)
+
+// BeginMask will start mask drawing. All next Draw2d* calls (until EndMask)
+// is not visible on surface, but write own shape into mask.
+// Transparent pixels (alpha < 0.5) is not written, so circles, text and
+// texture alpha can be used as mask shape. Many shapes can be drawn into
+// one mask, it will be union of them
+//
+// For example, circular avatar:
+//
+//	r.BeginMask()
+//	r.Draw2dCircle(...) // mask shape
+//	r.EndMask(false)
+//	r.Draw2dTexture(...) // visible only inside circle
+//	r.ClearMask()
+//
+// Masks is working only on main window surface, on off-screen
+// surfaces mask drawings is skipped and masks is not tested.
+// Mask is cleared on FrameStart
+func (r *Render) BeginMask() {
+	r.maskMode = vlk.MaskModeWrite
+
+	if r.CurrentSurface() == SurfaceMain {
+		r.maskUsed = true
+	}
+}
+
+// EndMask will finish mask drawing. All next drawings will be visible
+// only inside of mask shape (or only outside of it, when invert=true),
+// until ClearMask
+//
+// Every mask mode change will break draw batching
+func (r *Render) EndMask(invert bool) {
+	if invert {
+		r.maskMode = vlk.MaskModeOutside
+		return
+	}
+
+	r.maskMode = vlk.MaskModeInside
+}
+
+// ClearMask will clear mask and turn masking off, all next drawings
+// is not masked. New mask can be drawn after BeginMask
+//
+// Mask exists only on main surface, so it is cleared on main
+// surface, even when off-screen surface is current
+func (r *Render) ClearMask() {
+	r.maskMode = vlk.MaskModeNone
+
+	if !r.maskUsed {
+		// nothing to clear
+		return
+	}
+
+	r.maskUsed = false
+
+	current := r.CurrentSurface()
+	if current != SurfaceMain {
+		// main surface always exist, so switch cannot fail
+		_ = r.SetSurface(SurfaceMain)
+		defer func() {
+			_ = r.SetSurface(current)
+		}()
+	}
+
+	// mask is cleared by surface sized quad, that write 0 into mask
+	// (without transform and clip, because all mask should be cleared)
+	camera := r.currentCamera()
+	width, height := r.api.GetSurfaceSize()
+	color := glx.ColorWhite.VecRGBA()
+
+	tl := camera.screenToWorld(glx.Vec2{X: 0, Y: 0})
+	tr := camera.screenToWorld(glx.Vec2{X: width, Y: 0})
+	br := camera.screenToWorld(glx.Vec2{X: width, Y: height})
+	bl := camera.screenToWorld(glx.Vec2{X: 0, Y: height})
+
+	mode := vlk.DrawOptions{
+		PolygonMode: vulkan.PolygonModeFill,
+		MaskMode:    vlk.MaskModeClear,
+	}
+
+	r.api.Draw(buildInShaderTriangle, mode, &shaderInputUniversal2d{
+		vertexes: []shaderInputUniversal2dVertex{
+			{pos: tl, color: color},
+			{pos: tr, color: color},
+			{pos: br, color: color},
+		},
+	})
+	r.api.Draw(buildInShaderTriangle, mode, &shaderInputUniversal2d{
+		vertexes: []shaderInputUniversal2dVertex{
+			{pos: br, color: color},
+			{pos: bl, color: color},
+			{pos: tl, color: color},
+		},
+	})
+}
+
+func (r *Render) resetMask() {
+	r.maskMode = vlk.MaskModeNone
+	r.maskUsed = false
+}
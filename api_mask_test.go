@@ -0,0 +1,123 @@
+package vgl_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-glx/glx"
+	"github.com/go-glx/vgl"
+	"github.com/go-glx/vgl/vgltest"
+)
+
+func TestMask_CircleShape(t *testing.T) {
+	const size = 64
+
+	h := vgltest.New(t, vgltest.WithSize(size, size))
+
+	drawMasked := func(invert bool) func(rnd *vgl.Render) {
+		return func(rnd *vgl.Render) {
+			rnd.BeginMask()
+			rnd.Draw2dCircle(&vgl.Params2dCircle{
+				PosCenter:          glx.Vec2{X: size / 2, Y: size / 2},
+				PosRadius:          24,
+				PosUseCenterRadius: true,
+				Smooth:             -1,
+				Color:              glx.ColorWhite,
+			})
+			rnd.EndMask(invert)
+
+			rnd.Draw2dRect(&vgl.Params2dRect{
+				PosCenter:        glx.Vec2{X: size / 2, Y: size / 2},
+				PosSize:          glx.Vec2{X: size, Y: size},
+				PosUseCenterSize: true,
+				Color:            glx.ColorRed,
+				Filled:           true,
+			})
+			rnd.ClearMask()
+		}
+	}
+
+	// circle bounding box is [8 .. 56], so (12, 12) is inside of
+	// box, but outside of circle. Square mask will fill it
+	points := []struct {
+		name   string
+		x, y   int
+		inside bool
+	}{
+		{name: "center", x: 32, y: 32, inside: true},
+		{name: "near edge", x: 32, y: 12, inside: true},
+		{name: "box corner", x: 12, y: 12, inside: false},
+		{name: "box corner bottom right", x: 52, y: 52, inside: false},
+		{name: "outside box", x: 2, y: 2, inside: false},
+	}
+
+	for _, invert := range []bool{false, true} {
+		img, err := h.Frames(2, drawMasked(invert))
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		for _, point := range points {
+			visible := point.inside != invert
+			got := img.RGBAAt(point.x, point.y)
+
+			if visible {
+				assert.Equal(t, color.RGBA{R: 255, A: 255}, got, "invert=%v, %s (%d,%d) should be red", invert, point.name, point.x, point.y)
+			} else {
+				assert.NotEqual(t, uint8(255), got.R, "invert=%v, %s (%d,%d) should be masked", invert, point.name, point.x, point.y)
+			}
+		}
+	}
+}
+
+func TestMask_ClearFromSurface(t *testing.T) {
+	const size = 64
+
+	h := vgltest.New(t, vgltest.WithSize(size, size))
+
+	surface, err := h.Render().CreateSurface(size, size)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	defer h.Render().FreeSurface(surface)
+
+	maskRect := func(rnd *vgl.Render, x float32) {
+		rnd.BeginMask()
+		rnd.Draw2dRect(&vgl.Params2dRect{
+			PosCenter:        glx.Vec2{X: x, Y: size / 2},
+			PosSize:          glx.Vec2{X: size / 2, Y: size},
+			PosUseCenterSize: true,
+			Color:            glx.ColorWhite,
+			Filled:           true,
+		})
+		rnd.EndMask(false)
+	}
+
+	img, err := h.Frames(2, func(rnd *vgl.Render) {
+		// left half mask, cleared when off-screen surface is current
+		maskRect(rnd, size/4)
+		assert.NoError(t, rnd.SetSurface(surface.ID()))
+		rnd.ClearMask()
+		assert.NoError(t, rnd.SetSurface(vgl.SurfaceMain))
+
+		// right half mask, left half should not be in it
+		maskRect(rnd, size/4*3)
+		rnd.Draw2dRect(&vgl.Params2dRect{
+			PosCenter:        glx.Vec2{X: size / 2, Y: size / 2},
+			PosSize:          glx.Vec2{X: size, Y: size},
+			PosUseCenterSize: true,
+			Color:            glx.ColorRed,
+			Filled:           true,
+		})
+		rnd.ClearMask()
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, color.RGBA{R: 255, A: 255}, img.RGBAAt(size/4*3, size/2), "right half should be red")
+	assert.NotEqual(t, uint8(255), img.RGBAAt(size/4, size/2).R, "left half should be masked")
+}
@@ -21,35 +21,59 @@ import (
 // 2) next is 2d/3d API logic splitter.
 //    - 2d - current API
 //    - 3d - reserved for feature
-// 3) figure is buildIn shader type (point, line, triangle, circle, rect, texture)
+// 3) figure is buildIn shader type (point, line, polyline, triangle, circle, rect, polygon, path, texture)
 // 4) params called exactly as method, but "Params" prefix instead of "Draw"
 // 5) all params struct default golang values should be some valid value (and good defaults)
+// 6) all positions/sizes is in world pixels, that transformed to surface with current Camera2D
+//    (default camera not change anything, so world pixel is same as surface pixel)
 // -----------------------------------------------------------------------------
 
+const (
+	PointShapeSquare PointShape = iota // point is axis aligned square
+	PointShapeCircle                   // point is anti-aliased circle
+)
+
+// PointShape define shape of points with size
+type PointShape uint8
+
 // Params2dPoint is input for Draw2dPoint
 type Params2dPoint struct {
-	Pos       glx.Vec2  // pixel position from top,left corner of surface
-	Color     glx.Color // pixel color
-	NoCulling bool      // will send render command to GPU, even if all vertexes outside of visible screen
+	Pos       glx.Vec2   // pixel position from top,left corner of surface
+	Color     glx.Color  // pixel color
+	Size      float32    // default=1px; point size in pixels
+	Shape     PointShape // default=PointShapeSquare
+	NoCulling bool       // will send render command to GPU, even if all vertexes outside of visible screen
 }
 
 // Draw2dPoint will draw single point on current surface with current blend mode
 // slow draw call, should be used only for editor/debug draw/gizmos, etc...
+// use Draw2dPoints for drawing many points
 func (r *Render) Draw2dPoint(p *Params2dPoint) {
-	localPos := r.toLocalSpace2d(p.Pos)
+	pos := p.Pos
+
+	if p.Size > 1 || p.Shape != PointShapeSquare {
+		r.drawPointSized(&shaderInputPoints2dVertex{
+			pos:   pos,
+			color: p.Color.VecRGBA(),
+			size:  p.Size,
+			shape: p.Shape,
+		}, p.NoCulling)
+		return
+	}
 
-	if !p.NoCulling && !r.cullingPoint(localPos) {
+	if !p.NoCulling && !r.cullingPoint(pos) {
 		return
 	}
 
 	mode := vlk.DrawOptions{
 		PolygonMode: vulkan.PolygonModeFill,
+		BlendMode:   r.blendMode.toVLK(),
 	}
 
-	r.api.Draw(buildInShaderPoint, mode, &shaderInputUniversal2d{
+	r.draw(buildInShaderPoint, mode, &shaderInputUniversal2d{
 		vertexes: []shaderInputUniversal2dVertex{
 			{
-				pos:   localPos,
+				pos:   pos,
 				color: p.Color.VecRGBA(),
 			},
 		},
@@ -58,29 +82,129 @@ func (r *Render) Draw2dPoint(p *Params2dPoint) {
 
 // -----------------------------------------------------------------------------
 
+// Point2d is single point of Params2dPoints
+type Point2d struct {
+	Pos   glx.Vec2  // pixel position from top,left corner of surface
+	Color glx.Color // point color
+	Size  float32   // default=Params2dPoints.Size; point size in pixels
+}
+
+// Params2dPoints is input for Draw2dPoints
+type Params2dPoints struct {
+	Points    []Point2d  // points to draw
+	Size      float32    // default=1px; size in pixels for points without own size
+	Shape     PointShape // default=PointShapeSquare
+	NoCulling bool       // will send render command to GPU, even if all points outside of visible screen
+}
+
+// Draw2dPoints will draw many points (scatter plots, particles, etc...) on current
+// surface with current blend mode. Points is sent to GPU in chunks of 256 points
+// per instance, so this can draw hundreds of thousands of points per frame
+//
+// Points bigger than GPU point size limit (see VkPhysicalDeviceLimits::pointSizeRange)
+// is drawn as separated quads
+func (r *Render) Draw2dPoints(p *Params2dPoints) {
+	if len(p.Points) == 0 {
+		return
+	}
+
+	defaultSize := p.Size
+	if defaultSize <= 0 {
+		defaultSize = 1
+	}
+
+	mode := vlk.DrawOptions{
+		PolygonMode: vulkan.PolygonModeFill,
+		BlendMode:   r.blendMode.toVLK(),
+	}
+
+	scale := r.screenScale()
+	vertexes := make([]shaderInputPoints2dVertex, 0, len(p.Points))
+
+	for _, point := range p.Points {
+		size := point.Size
+		if size <= 0 {
+			size = defaultSize
+		}
+
+		if !p.NoCulling && !r.cullingCircle(point.Pos, size/2) {
+			continue
+		}
+
+		vertex := shaderInputPoints2dVertex{
+			pos:   point.Pos,
+			color: point.Color.VecRGBA(),
+			size:  size,
+			shape: p.Shape,
+		}
+
+		if size*scale > r.pointSizeMax {
+			r.drawPointQuad(&vertex)
+			continue
+		}
+
+		vertexes = append(vertexes, vertex)
+	}
+
+	for _, chunk := range pointsChunks(vertexes) {
+		r.draw(buildInShaderPoints, mode, &shaderInputPoints2d{vertexes: chunk})
+	}
+}
+
+func (r *Render) drawPointSized(vertex *shaderInputPoints2dVertex, noCulling bool) {
+	if vertex.size <= 0 {
+		vertex.size = 1
+	}
+
+	if !noCulling && !r.cullingCircle(vertex.pos, vertex.size/2) {
+		return
+	}
+
+	if vertex.size*r.screenScale() > r.pointSizeMax {
+		r.drawPointQuad(vertex)
+		return
+	}
+
+	mode := vlk.DrawOptions{
+		PolygonMode: vulkan.PolygonModeFill,
+		BlendMode:   r.blendMode.toVLK(),
+	}
+
+	r.draw(buildInShaderPointSized, mode, &shaderInputPoints2d{
+		vertexes: []shaderInputPoints2dVertex{*vertex},
+	})
+}
+
+// drawPointQuad draw point as SDF quad, when point
+// is bigger than GPU supported point size
+func (r *Render) drawPointQuad(vertex *shaderInputPoints2dVertex) {
+	half := vertex.size / 2
+	radius := float32(0)
+	if vertex.shape == PointShapeCircle {
+		radius = half
+	}
+
+	shape := newSDFRoundedRect(vertex.pos, glx.Vec2{X: half, Y: half}, 0, [4]float32{radius, radius, radius, radius})
+	r.drawSDF(&shape, &sdfStyle{color: vertex.color}, true)
+}
+
+// -----------------------------------------------------------------------------
+
 // Params2dLine is input for Draw2dLine
 type Params2dLine struct {
 	Pos              [2]glx.Vec2  // pixel positions from top,left corner of surface
 	Color            glx.Color    // line color
 	ColorGradient    [2]glx.Color // color for each vertex
 	ColorUseGradient bool         // will use ColorGradient instead of Color
-	Width            float32      // default=1px; max=32px; line width (1px is only guaranteed to fast GPU render).
+	Width            float32      // default=1px; line width (max=32px), with Antialiased can be any, including less than 1px
+	Cap              LineCap      // default=LineCapButt; how line ends is drawn (only with Antialiased)
+	Antialiased      bool         // will draw smooth quad with caps, instead of native GPU line (1px) or hard-edged quad
 	NoCulling        bool         // will send render command to GPU, even if all vertexes outside of visible screen
 }
 
 // Draw2dLine will draw line on current surface with current blend mode
 func (r *Render) Draw2dLine(p *Params2dLine) {
-	if p.Width < 1 {
-		p.Width = 1
-	}
-	if p.Width > 32 {
-		p.Width = 32
-	}
-
-	localPos := [2]glx.Vec2{
-		r.toLocalSpace2d(p.Pos[0]),
-		r.toLocalSpace2d(p.Pos[1]),
-	}
+	pos := p.Pos
 
 	localColor := [2]glx.Vec4{}
 	if p.ColorUseGradient {
@@ -91,24 +215,42 @@ func (r *Render) Draw2dLine(p *Params2dLine) {
 		localColor[1] = localColor[0]
 	}
 
+	if p.Antialiased {
+		width := p.Width
+		if width <= 0 {
+			width = 1
+		}
+
+		r.drawLineAA(pos, localColor, width, p.Cap, p.NoCulling)
+		return
+	}
+
+	if p.Width < 1 {
+		p.Width = 1
+	}
+	if p.Width > 32 {
+		p.Width = 32
+	}
+
 	if p.Width == 1 {
 		// native GPU line (faster that emulating with rect)
-		if !p.NoCulling && !r.cullingLine(localPos) {
+		if !p.NoCulling && !r.cullingLine(pos) {
 			return
 		}
 
 		mode := vlk.DrawOptions{
 			PolygonMode: vulkan.PolygonModeLine,
+			BlendMode:   r.blendMode.toVLK(),
 		}
 
-		r.api.Draw(buildInShaderLine, mode, &shaderInputUniversal2d{
+		r.draw(buildInShaderLine, mode, &shaderInputUniversal2d{
 			vertexes: []shaderInputUniversal2dVertex{
 				{
-					pos:   localPos[0],
+					pos:   pos[0],
 					color: localColor[0],
 				},
 				{
-					pos:   localPos[1],
+					pos:   pos[1],
 					color: localColor[1],
 				},
 			},
@@ -118,12 +260,12 @@ func (r *Render) Draw2dLine(p *Params2dLine) {
 
 	// not all GPU support of lines with width 1px+
 	// so, in case of custom width, we will emulate it with rect
-	angle := localPos[0].AngleTo(localPos[1])
-	offset := r.toLocalAspectRation(p.Width) / 2
-	topLeft := localPos[0].PolarOffset(offset, angle+(math.Pi/2))
-	bottomLeft := localPos[0].PolarOffset(offset, angle-(math.Pi/2))
-	topRight := localPos[1].PolarOffset(offset, angle+(math.Pi/2))
-	bottomRight := localPos[1].PolarOffset(offset, angle-(math.Pi/2))
+	angle := pos[0].AngleTo(pos[1])
+	offset := p.Width / 2
+	topLeft := pos[0].PolarOffset(offset, angle+(math.Pi/2))
+	bottomLeft := pos[0].PolarOffset(offset, angle-(math.Pi/2))
+	topRight := pos[1].PolarOffset(offset, angle+(math.Pi/2))
+	bottomRight := pos[1].PolarOffset(offset, angle-(math.Pi/2))
 
 	rectPos := [4]glx.Vec2{topLeft, topRight, bottomRight, bottomLeft}
 	if !p.NoCulling && !r.cullingRect(rectPos) {
@@ -132,16 +274,17 @@ func (r *Render) Draw2dLine(p *Params2dLine) {
 
 	mode := vlk.DrawOptions{
 		PolygonMode: vulkan.PolygonModeFill,
+		BlendMode:   r.blendMode.toVLK(),
 	}
 
-	r.api.Draw(buildInShaderTriangle, mode, &shaderInputUniversal2d{
+	r.draw(buildInShaderTriangle, mode, &shaderInputUniversal2d{
 		vertexes: []shaderInputUniversal2dVertex{
 			{pos: rectPos[0], color: localColor[0]}, // tl
 			{pos: rectPos[1], color: localColor[1]}, // tr
 			{pos: rectPos[2], color: localColor[1]}, // br
 		},
 	})
-	r.api.Draw(buildInShaderTriangle, mode, &shaderInputUniversal2d{
+	r.draw(buildInShaderTriangle, mode, &shaderInputUniversal2d{
 		vertexes: []shaderInputUniversal2dVertex{
 			{pos: rectPos[2], color: localColor[1]}, // br
 			{pos: rectPos[3], color: localColor[0]}, // bl
@@ -150,6 +293,114 @@ func (r *Render) Draw2dLine(p *Params2dLine) {
 	})
 }
 
+// drawLineAA draw line as quad with distance to segment in
+// fragment shader (smooth edges at any width and zoom)
+func (r *Render) drawLineAA(pos [2]glx.Vec2, color [2]glx.Vec4, width float32, lineCap LineCap, noCulling bool) {
+	// one screen pixel for anti-aliasing
+	margin := 1 / r.screenScale()
+	halfWidth := width / 2
+	delta := pos[1].Sub(pos[0])
+
+	quad, local := lineQuad(pos[0], pos[1], halfWidth, lineCap, margin)
+	if !noCulling && !r.cullingRect(quad) {
+		return
+	}
+
+	mode := vlk.DrawOptions{
+		PolygonMode: vulkan.PolygonModeFill,
+		BlendMode:   r.blendMode.toVLK(),
+	}
+
+	r.draw(buildInShaderLineAA, mode, &shaderInputLine2d{
+		vertexes: []shaderInputLine2dVertex{
+			{pos: quad[0], color: color[0], local: local[0]}, // tl
+			{pos: quad[1], color: color[1], local: local[1]}, // tr
+			{pos: quad[2], color: color[1], local: local[2]}, // br
+			{pos: quad[3], color: color[0], local: local[3]}, // bl
+		},
+		halfLength: float32(math.Hypot(float64(delta.X), float64(delta.Y))) / 2,
+		halfWidth:  halfWidth,
+		cap:        lineCap,
+	})
+}
+
+// -----------------------------------------------------------------------------
+
+// Params2dPolyline is input for Draw2dPolyline
+type Params2dPolyline struct {
+	Points           []glx.Vec2  // pixel positions from top,left corner of surface
+	Color            glx.Color   // line color
+	ColorGradient    []glx.Color // color for each point (Color is used for points without gradient color)
+	ColorUseGradient bool        // will use ColorGradient instead of Color
+	Width            float32     // default=1px; line width
+	Join             LineJoin    // default=LineJoinMiter; how segments connected in corners
+	Cap              LineCap     // default=LineCapButt; how open line (and every dash) ends are drawn
+	MiterLimit       float32     // default=4; max ratio of miter length to line width, bevel join used after that
+	Closed           bool        // connect last point with first one
+	Dash             []float32   // dash pattern in pixels: dash, gap, dash, gap... (empty is solid line, zero length dash is dot of two caps)
+	DashOffset       float32     // distance in pixels into dash pattern at line start
+	NoCulling        bool        // will send render command to GPU, even if all vertexes outside of visible screen
+}
+
+// Draw2dPolyline will draw connected line segments (stroke) on current surface with current blend mode
+// stroke is tessellated into triangles on CPU every call, so prefer Draw2dLine for single thin lines
+//
+// Stroke triangles not overlap, so translucent colors is blended evenly, except
+// self-crossing lines and very sharp corners on short segments (shorter than
+// stroke width), draw such strokes opaque into off-screen surface instead
+func (r *Render) Draw2dPolyline(p *Params2dPolyline) {
+	if len(p.Points) < 2 {
+		return
+	}
+
+	width := p.Width
+	if width < 1 {
+		width = 1
+	}
+
+	miterLimit := p.MiterLimit
+	if miterLimit < 1 {
+		miterLimit = strokeDefaultMiterLimit
+	}
+
+	if !p.NoCulling && !r.cullingPolyline(p.Points, width*miterLimit) {
+		return
+	}
+
+	colors := make([]glx.Vec4, len(p.Points))
+	for i := range colors {
+		if p.ColorUseGradient && i < len(p.ColorGradient) {
+			colors[i] = p.ColorGradient[i].VecRGBA()
+			continue
+		}
+
+		colors[i] = p.Color.VecRGBA()
+	}
+
+	triangles := strokePolyline(strokeStyle{
+		width:      width,
+		join:       p.Join,
+		cap:        p.Cap,
+		miterLimit: miterLimit,
+		closed:     p.Closed,
+		dash:       p.Dash,
+		dashOffset: p.DashOffset,
+	}, p.Points, colors)
+
+	mode := vlk.DrawOptions{
+		PolygonMode: vulkan.PolygonModeFill,
+		BlendMode:   r.blendMode.toVLK(),
+	}
+
+	for i := range triangles {
+		// slice of triangle from array, not of loop variable, input
+		// is stored until frame end
+		r.draw(buildInShaderTriangle, mode, &shaderInputUniversal2d{
+			vertexes: triangles[i][:],
+		})
+	}
+}
+
 // -----------------------------------------------------------------------------
 
 // Params2dTriangle is input for Draw2dTriangle
@@ -165,13 +416,9 @@ type Params2dTriangle struct {
 // Draw2dTriangle will draw triangle on current surface with current blend mode
 // Params2dTriangle.Pos must be in clock-wise order
 func (r *Render) Draw2dTriangle(p *Params2dTriangle) {
-	localPos := [3]glx.Vec2{
-		r.toLocalSpace2d(p.Pos[0]),
-		r.toLocalSpace2d(p.Pos[1]),
-		r.toLocalSpace2d(p.Pos[2]),
-	}
+	pos := p.Pos
 
-	if !p.NoCulling && !r.cullingTriangle(localPos) {
+	if !p.NoCulling && !r.cullingTriangle(pos) {
 		return
 	}
 
@@ -188,17 +435,18 @@ func (r *Render) Draw2dTriangle(p *Params2dTriangle) {
 
 	mode := vlk.DrawOptions{
 		PolygonMode: vulkan.PolygonModeLine,
+		BlendMode:   r.blendMode.toVLK(),
 	}
 
 	if p.Filled {
 		mode.PolygonMode = vulkan.PolygonModeFill
 	}
 
-	r.api.Draw(buildInShaderTriangle, mode, &shaderInputUniversal2d{
+	r.draw(buildInShaderTriangle, mode, &shaderInputUniversal2d{
 		vertexes: []shaderInputUniversal2dVertex{
-			{pos: localPos[0], color: localColor[0]},
-			{pos: localPos[1], color: localColor[1]},
-			{pos: localPos[2], color: localColor[2]},
+			{pos: pos[0], color: localColor[0]},
+			{pos: pos[1], color: localColor[1]},
+			{pos: pos[2], color: localColor[2]},
 		},
 	})
 }
@@ -208,10 +456,16 @@ func (r *Render) Draw2dTriangle(p *Params2dTriangle) {
 // Params2dRect is input for Draw2dRect
 type Params2dRect struct {
 	Pos              [4]glx.Vec2  // pixel position from top,left corner of surface in clock-wise order
+	PosCenter        glx.Vec2     // rect center in pixels
+	PosSize          glx.Vec2     // rect width and height in pixels
+	PosRotation      glx.Angle    // rotation in radians (clock-wise) around PosCenter + PosOrigin
+	PosOrigin        glx.Vec2     // rotation pivot offset from PosCenter in pixels
+	PosUseCenterSize bool         // will use PosCenter, PosSize, PosRotation, PosOrigin and ignore Pos (will be calculated)
 	Color            glx.Color    // color for all vertexes
 	ColorGradient    [4]glx.Color // color for each vertex
 	ColorUseGradient bool         // will use ColorGradient instead of Color
 	Filled           bool         // fill rect with color/gradient
+	Gradient         *Gradient    // fill gradient (used instead of Color and ColorGradient, only for filled rect)
 	NoCulling        bool         // will send render command to GPU, even if all vertexes outside of visible screen
 }
 
@@ -222,14 +476,12 @@ type Params2dRect struct {
 //   3) bottom-right
 //   4) bottom-left
 func (r *Render) Draw2dRect(p *Params2dRect) {
-	localPos := [4]glx.Vec2{
-		r.toLocalSpace2d(p.Pos[0]),
-		r.toLocalSpace2d(p.Pos[1]),
-		r.toLocalSpace2d(p.Pos[2]),
-		r.toLocalSpace2d(p.Pos[3]),
+	pos := p.Pos
+	if p.PosUseCenterSize {
+		pos = rectFromCenter(p.PosCenter, p.PosSize, p.PosRotation, p.PosOrigin)
 	}
 
-	if !p.NoCulling && !r.cullingRect(localPos) {
+	if !p.NoCulling && !r.cullingRect(pos) {
 		return
 	}
 
@@ -249,14 +501,15 @@ func (r *Render) Draw2dRect(p *Params2dRect) {
 	if !p.Filled {
 		mode := vlk.DrawOptions{
 			PolygonMode: vulkan.PolygonModeLine,
+			BlendMode:   r.blendMode.toVLK(),
 		}
 
-		r.api.Draw(buildInShaderRect, mode, &shaderInputUniversal2d{
+		r.draw(buildInShaderRect, mode, &shaderInputUniversal2d{
 			vertexes: []shaderInputUniversal2dVertex{
-				{pos: localPos[0], color: localColor[0]},
-				{pos: localPos[1], color: localColor[1]},
-				{pos: localPos[2], color: localColor[2]},
-				{pos: localPos[3], color: localColor[3]},
+				{pos: pos[0], color: localColor[0]},
+				{pos: pos[1], color: localColor[1]},
+				{pos: pos[2], color: localColor[2]},
+				{pos: pos[3], color: localColor[3]},
 			},
 		})
 
@@ -269,31 +522,221 @@ func (r *Render) Draw2dRect(p *Params2dRect) {
 
 	mode := vlk.DrawOptions{
 		PolygonMode: vulkan.PolygonModeFill,
+		BlendMode:   r.blendMode.toVLK(),
 	}
 
-	r.api.Draw(buildInShaderTriangle, mode, &shaderInputUniversal2d{
+	if p.Gradient != nil {
+		min, max := pointsBounds(pos[:])
+		r.drawGradientTriangles([][3]glx.Vec2{
+			{pos[0], pos[1], pos[2]}, // tl, tr, br
+			{pos[2], pos[3], pos[0]}, // br, bl, tl
+		}, p.Gradient.resolve(min, max), mode)
+		return
+	}
+
+	r.draw(buildInShaderTriangle, mode, &shaderInputUniversal2d{
 		vertexes: []shaderInputUniversal2dVertex{
-			{pos: localPos[0], color: localColor[0]}, // tl
-			{pos: localPos[1], color: localColor[1]}, // tr
-			{pos: localPos[2], color: localColor[2]}, // br
+			{pos: pos[0], color: localColor[0]}, // tl
+			{pos: pos[1], color: localColor[1]}, // tr
+			{pos: pos[2], color: localColor[2]}, // br
 		},
 	})
-	r.api.Draw(buildInShaderTriangle, mode, &shaderInputUniversal2d{
+	r.draw(buildInShaderTriangle, mode, &shaderInputUniversal2d{
 		vertexes: []shaderInputUniversal2dVertex{
-			{pos: localPos[2], color: localColor[2]}, // br
-			{pos: localPos[3], color: localColor[3]}, // bl
-			{pos: localPos[0], color: localColor[0]}, // tl
+			{pos: pos[2], color: localColor[2]}, // br
+			{pos: pos[3], color: localColor[3]}, // bl
+			{pos: pos[0], color: localColor[0]}, // tl
 		},
 	})
 }
 
 // -----------------------------------------------------------------------------
 
+// Params2dPolygon is input for Draw2dPolygon
+type Params2dPolygon struct {
+	Points    []glx.Vec2   // pixel positions of outer ring from top,left corner of surface in any order (cw/ccw)
+	Holes     [][]glx.Vec2 // rings cut out from polygon, must be inside outer ring
+	Color     glx.Color    // color for all vertexes
+	Filled    bool         // fill polygon with color, otherwise outer ring and holes will be outlined
+	Gradient  *Gradient    // fill gradient (used instead of Color, only for filled polygon)
+	NoCulling bool         // will send render command to GPU, even if all vertexes outside of visible screen
+}
+
+// Draw2dPolygon will draw any (concave, with holes) polygon on current surface with current blend mode
+// filled polygon is triangulated on CPU every call, so prefer Draw2dTriangle/Draw2dRect for simple shapes
+func (r *Render) Draw2dPolygon(p *Params2dPolygon) {
+	if len(p.Points) < 2 {
+		return
+	}
+
+	if !p.NoCulling && !r.cullingPolygon(p.Points) {
+		return
+	}
+
+	color := p.Color.VecRGBA()
+
+	if !p.Filled {
+		mode := vlk.DrawOptions{
+			PolygonMode: vulkan.PolygonModeLine,
+			BlendMode:   r.blendMode.toVLK(),
+		}
+
+		r.drawOutline(p.Points, color, mode)
+		for _, hole := range p.Holes {
+			r.drawOutline(hole, color, mode)
+		}
+
+		return
+	}
+
+	mode := vlk.DrawOptions{
+		PolygonMode: vulkan.PolygonModeFill,
+		BlendMode:   r.blendMode.toVLK(),
+	}
+
+	triangles := triangulatePolygon(p.Points, p.Holes)
+	if p.Gradient != nil {
+		min, max := pointsBounds(p.Points)
+		r.drawGradientTriangles(triangles, p.Gradient.resolve(min, max), mode)
+		return
+	}
+
+	for _, tri := range triangles {
+		r.draw(buildInShaderTriangle, mode, &shaderInputUniversal2d{
+			vertexes: []shaderInputUniversal2dVertex{
+				{pos: tri[0], color: color},
+				{pos: tri[1], color: color},
+				{pos: tri[2], color: color},
+			},
+		})
+	}
+}
+
+func (r *Render) drawOutline(ring []glx.Vec2, color glx.Vec4, mode vlk.DrawOptions) {
+	for _, chunk := range outlineChunks(ring) {
+		vertexes := make([]shaderInputUniversal2dVertex, 0, outlineChunkSize)
+		for _, pos := range chunk {
+			vertexes = append(vertexes, shaderInputUniversal2dVertex{pos: pos, color: color})
+		}
+
+		r.draw(buildInShaderOutline, mode, &shaderInputUniversal2d{
+			vertexes: vertexes,
+		})
+	}
+}
+
+// drawGradientTriangles draw triangles filled with same gradient
+// (every triangle has own copy of gradient in storage buffer)
+func (r *Render) drawGradientTriangles(triangles [][3]glx.Vec2, gradient shaderGradient, mode vlk.DrawOptions) {
+	for _, tri := range triangles {
+		r.draw(buildInShaderGradient, mode, &shaderInputGradient2d{
+			vertexes: tri,
+			gradient: gradient,
+		})
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+// Params2dPath is input for Draw2dPath
+type Params2dPath struct {
+	Path       *Path2d   // vector path, that will be filled or stroked
+	Color      glx.Color // color for all vertexes
+	Filled     bool      // fill path with FillRule, otherwise path will be stroked
+	FillRule   FillRule  // default=FillRuleNonZero
+	Width      float32   // default=1px; stroke width
+	Join       LineJoin  // default=LineJoinMiter; stroke joins
+	Cap        LineCap   // default=LineCapButt; stroke caps of open sub paths (and dashes)
+	MiterLimit float32   // default=4; max ratio of miter length to stroke width
+	Dash       []float32 // stroke dash pattern in pixels: dash, gap, dash, gap... (empty is solid line)
+	DashOffset float32   // distance in pixels into dash pattern at sub path start
+	Tolerance  float32   // default=0.25px; max distance between curve and its flattened lines on surface
+	Gradient   *Gradient // fill/stroke gradient (used instead of Color), relative to path bounds
+	NoCulling  bool      // will send render command to GPU, even if all vertexes outside of visible screen
+}
+
+// Draw2dPath will draw vector path on current surface with current blend mode
+// tessellation is cached inside Path2d, and reused while path, params and camera zoom (and transform scale) is not changed
+func (r *Render) Draw2dPath(p *Params2dPath) {
+	if p.Path == nil || p.Path.IsEmpty() {
+		return
+	}
+
+	width := p.Width
+	if width < 1 {
+		width = 1
+	}
+
+	miterLimit := p.MiterLimit
+	if miterLimit < 1 {
+		miterLimit = strokeDefaultMiterLimit
+	}
+
+	if !p.NoCulling {
+		min, max := p.Path.Bounds()
+		margin := float32(0)
+		if !p.Filled {
+			margin = width * miterLimit
+		}
+
+		if !r.cullingPolyline([]glx.Vec2{min, max}, margin) {
+			return
+		}
+	}
+
+	// tolerance is in surface pixels, convert it to world
+	tolerance := p.Tolerance
+	if tolerance <= 0 {
+		tolerance = path2dDefaultTolerance
+	}
+	tolerance /= r.screenScale()
+
+	var triangles [][3]glx.Vec2
+	if p.Filled {
+		triangles = p.Path.tessellateFill(tolerance, p.FillRule)
+	} else {
+		triangles = p.Path.tessellateStroke(tolerance, strokeStyle{
+			width:      width,
+			join:       p.Join,
+			cap:        p.Cap,
+			miterLimit: miterLimit,
+			dash:       p.Dash,
+			dashOffset: p.DashOffset,
+		})
+	}
+
+	mode := vlk.DrawOptions{
+		PolygonMode: vulkan.PolygonModeFill,
+		BlendMode:   r.blendMode.toVLK(),
+	}
+
+	if p.Gradient != nil {
+		min, max := p.Path.Bounds()
+		r.drawGradientTriangles(triangles, p.Gradient.resolve(min, max), mode)
+		return
+	}
+
+	color := p.Color.VecRGBA()
+	for _, tri := range triangles {
+		r.draw(buildInShaderTriangle, mode, &shaderInputUniversal2d{
+			vertexes: []shaderInputUniversal2dVertex{
+				{pos: tri[0], color: color},
+				{pos: tri[1], color: color},
+				{pos: tri[2], color: color},
+			},
+		})
+	}
+}
+
+// -----------------------------------------------------------------------------
+
 // Params2dCircle is input for Draw2dCircle
 type Params2dCircle struct {
 	Pos                [4]glx.Vec2  // pixel position from top,left corner of surface in clock-wise order
 	PosCenter          glx.Vec2     // position in pixels from top,left corner of surface
 	PosRadius          float32      // radius in pixels
+	PosRotation        glx.Angle    // rotation in radians (clock-wise) around PosCenter + PosOrigin (with PosUseCenterRadius)
+	PosOrigin          glx.Vec2     // rotation pivot offset from PosCenter in pixels (with PosUseCenterRadius)
 	PosUseCenterRadius bool         // will use PosCenter and PosRadius and ignore Pos (will be calculated)
 	HoleRadius         float32      // value [0 .. 1]. 0=without hole, 0.1=90% circle is visible, 1=invisible circle
 	Smooth             float32      // value [-1, 0 .. 1]. -1=no smooth, 0.005=default, 1=full blur (default value will be used, if no value (0) specified)
@@ -309,21 +752,24 @@ func (r *Render) Draw2dCircle(p *Params2dCircle) {
 		return
 	}
 
-	localPos := [4]glx.Vec2{}
+	pos := p.Pos
 	if p.PosUseCenterRadius {
-		localPos[0] = r.toLocalSpace2d(p.PosCenter.Add(glx.Vec2{X: -p.PosRadius, Y: -p.PosRadius})) // tl
-		localPos[1] = r.toLocalSpace2d(p.PosCenter.Add(glx.Vec2{X: +p.PosRadius, Y: -p.PosRadius})) // tr
-		localPos[2] = r.toLocalSpace2d(p.PosCenter.Add(glx.Vec2{X: +p.PosRadius, Y: +p.PosRadius})) // br
-		localPos[3] = r.toLocalSpace2d(p.PosCenter.Add(glx.Vec2{X: -p.PosRadius, Y: +p.PosRadius})) // bl
-	} else {
-		localPos[0] = r.toLocalSpace2d(p.Pos[0])
-		localPos[1] = r.toLocalSpace2d(p.Pos[1])
-		localPos[2] = r.toLocalSpace2d(p.Pos[2])
-		localPos[3] = r.toLocalSpace2d(p.Pos[3])
+		diameter := p.PosRadius * 2
+		pos = rectFromCenter(p.PosCenter, glx.Vec2{X: diameter, Y: diameter}, p.PosRotation, p.PosOrigin)
 	}
 
-	if !p.NoCulling && !r.cullingRect(localPos) {
-		return
+	if !p.NoCulling {
+		visible := false
+		if p.PosUseCenterRadius {
+			// center is moved, when rotated around origin
+			visible = r.cullingCircle(pos[0].Add(pos[2]).Scale(0.5), p.PosRadius)
+		} else {
+			visible = r.cullingRect(pos)
+		}
+
+		if !visible {
+			return
+		}
 	}
 
 	if p.Smooth == 0 {
@@ -336,14 +782,109 @@ func (r *Render) Draw2dCircle(p *Params2dCircle) {
 		p.Smooth = 0
 	}
 
+	r.drawCircleShape(pos, p.Color, p.ColorGradient, p.ColorUseGradient, &shaderInputCircle2d{
+		thickness: glx.Vec1{X: glx.Clamp(p.HoleRadius, 0, 1)},
+		smooth:    glx.Vec1{X: glx.Clamp(p.Smooth, 0, 1)},
+	})
+}
+
+// -----------------------------------------------------------------------------
+
+// Params2dEllipse is input for Draw2dEllipse
+type Params2dEllipse struct {
+	PosCenter        glx.Vec2     // position in pixels from top,left corner of surface
+	Radius           glx.Vec2     // x,y radius in pixels
+	Rotation         glx.Angle    // default=0; rotation (radians) around center
+	Thickness        float32      // default=0 (filled); ring thickness in pixels
+	Color            glx.Color    // color for ellipse body/ring
+	ColorGradient    [4]glx.Color // color for ellipse part (tl, tr, br, bl)
+	ColorUseGradient bool         // will use ColorGradient instead of Color
+	NoCulling        bool         // will send render command to GPU, even if all vertexes outside of visible screen
+}
+
+// Draw2dEllipse will draw ellipse (or ellipse ring) on current surface with current blend mode
+func (r *Render) Draw2dEllipse(p *Params2dEllipse) {
+	if p.Radius.X <= 0 || p.Radius.Y <= 0 {
+		return
+	}
+
+	pos := ellipseQuad(p.PosCenter, p.Radius, float64(p.Rotation))
+
+	if !p.NoCulling && !r.cullingRect(pos) {
+		return
+	}
+
+	r.drawCircleShape(pos, p.Color, p.ColorGradient, p.ColorUseGradient, &shaderInputCircle2d{
+		mode:      circleModeEllipse,
+		ringWidth: glx.Clamp(p.Thickness, 0, float32(math.Min(float64(p.Radius.X), float64(p.Radius.Y)))),
+		radius:    p.Radius,
+	})
+}
+
+// -----------------------------------------------------------------------------
+
+// Params2dArc is input for Draw2dArc
+type Params2dArc struct {
+	PosCenter        glx.Vec2     // position in pixels from top,left corner of surface
+	Radius           float32      // outer radius in pixels
+	AngleStart       glx.Angle    // arc start angle (radians), 0=right, PI/2=bottom
+	AngleEnd         glx.Angle    // arc end angle (radians), arc is drawn clock-wise from AngleStart to AngleEnd
+	Thickness        float32      // default=0 (filled sector); ring thickness in pixels
+	RoundEnds        bool         // arc ends is rounded (by default ends is flat)
+	Pie              bool         // draw pie sector from center (Thickness is ignored)
+	Color            glx.Color    // color for arc
+	ColorGradient    [4]glx.Color // color for arc circle part (tl, tr, br, bl)
+	ColorUseGradient bool         // will use ColorGradient instead of Color
+	NoCulling        bool         // will send render command to GPU, even if all vertexes outside of visible screen
+}
+
+// Draw2dArc will draw circle arc (ring part) or pie sector on current surface with current blend mode
+func (r *Render) Draw2dArc(p *Params2dArc) {
+	if p.Radius <= 0 || p.AngleStart == p.AngleEnd {
+		return
+	}
+
+	if !p.NoCulling && !r.cullingCircle(p.PosCenter, p.Radius) {
+		return
+	}
+
+	input := &shaderInputCircle2d{
+		mode:      circleModeEllipse,
+		ringWidth: glx.Clamp(p.Thickness, 0, p.Radius),
+		radius:    glx.Vec2{X: p.Radius, Y: p.Radius},
+	}
+
+	if p.Pie {
+		input.ringWidth = 0
+	}
+
+	middle, half, full := arcAngles(float64(p.AngleStart), float64(p.AngleEnd))
+	if !full {
+		input.mode |= circleModeArc
+		input.arc = glx.Vec2{X: float32(middle), Y: float32(half)}
+
+		if p.Pie {
+			input.mode |= circleModePie
+		}
+
+		if p.RoundEnds {
+			input.mode |= circleModeRoundEnds
+		}
+	}
+
+	pos := ellipseQuad(p.PosCenter, input.radius, 0)
+	r.drawCircleShape(pos, p.Color, p.ColorGradient, p.ColorUseGradient, input)
+}
+
+func (r *Render) drawCircleShape(pos [4]glx.Vec2, color glx.Color, gradient [4]glx.Color, useGradient bool, input *shaderInputCircle2d) {
 	localColor := [4]glx.Vec4{}
-	if p.ColorUseGradient {
-		localColor[0] = p.ColorGradient[0].VecRGBA()
-		localColor[1] = p.ColorGradient[1].VecRGBA()
-		localColor[2] = p.ColorGradient[2].VecRGBA()
-		localColor[3] = p.ColorGradient[3].VecRGBA()
+	if useGradient {
+		localColor[0] = gradient[0].VecRGBA()
+		localColor[1] = gradient[1].VecRGBA()
+		localColor[2] = gradient[2].VecRGBA()
+		localColor[3] = gradient[3].VecRGBA()
 	} else {
-		localColor[0] = p.Color.VecRGBA()
+		localColor[0] = color.VecRGBA()
 		localColor[1] = localColor[0]
 		localColor[2] = localColor[0]
 		localColor[3] = localColor[0]
@@ -351,16 +892,94 @@ func (r *Render) Draw2dCircle(p *Params2dCircle) {
 
 	mode := vlk.DrawOptions{
 		PolygonMode: vulkan.PolygonModeFill,
+		BlendMode:   r.blendMode.toVLK(),
 	}
 
-	r.api.Draw(buildInShaderCircle, mode, &shaderInputCircle2d{
-		vertexes: []shaderInputCircle2dVertex{
-			{pos: localPos[0], color: localColor[0]},
-			{pos: localPos[1], color: localColor[1]},
-			{pos: localPos[2], color: localColor[2]},
-			{pos: localPos[3], color: localColor[3]},
+	input.vertexes = []shaderInputCircle2dVertex{
+		{pos: pos[0], color: localColor[0]},
+		{pos: pos[1], color: localColor[1]},
+		{pos: pos[2], color: localColor[2]},
+		{pos: pos[3], color: localColor[3]},
+	}
+
+	r.draw(buildInShaderCircle, mode, input)
+}
+
+// -----------------------------------------------------------------------------
+
+// Params2dTexture is input for Draw2dTexture
+type Params2dTexture struct {
+	Texture          *Texture    // texture created with Render.CreateTexture
+	Pos              [4]glx.Vec2 // pixel position from top,left corner of surface in clock-wise order
+	PosCenter        glx.Vec2    // sprite center in pixels
+	PosSize          glx.Vec2    // default=texture size; sprite width and height in pixels
+	PosRotation      glx.Angle   // rotation in radians (clock-wise) around PosCenter + PosOrigin
+	PosOrigin        glx.Vec2    // rotation pivot offset from PosCenter in pixels
+	PosUseCenterSize bool        // will use PosCenter, PosSize, PosRotation, PosOrigin and ignore Pos (will be calculated)
+	UV               [2]glx.Vec2 // texture region (top-left, bottom-right) in [0 .. 1] space. Full texture will be used, if no value (0) specified
+	Tint             glx.Color   // color multiplied with every texture pixel
+	TintUse          bool        // will use Tint (by default texture is drawn as is)
+	AlphaGradient    [4]float32  // value [0 .. 1]. Alpha for each vertex (tl, tr, br, bl)
+	AlphaUseGradient bool        // will use AlphaGradient (by default texture is fully opaque)
+	NoCulling        bool        // will send render command to GPU, even if all vertexes outside of visible screen
+}
+
+// Draw2dTexture will draw texture (sprite) on current surface with current blend mode
+// order of Params2dTexture.Pos must be specified in order:
+//   1) top-left
+//   2) top-right
+//   3) bottom-right
+//   4) bottom-left
+func (r *Render) Draw2dTexture(p *Params2dTexture) {
+	if p.Texture == nil || p.Texture.id == 0 {
+		return
+	}
+
+	pos := p.Pos
+	if p.PosUseCenterSize {
+		size := p.PosSize
+		if size == (glx.Vec2{}) {
+			// default value (if no specified)
+			size = glx.Vec2{X: p.Texture.width, Y: p.Texture.height}
+		}
+
+		pos = rectFromCenter(p.PosCenter, size, p.PosRotation, p.PosOrigin)
+	}
+
+	if !p.NoCulling && !r.cullingRect(pos) {
+		return
+	}
+
+	uv := p.UV
+	if uv == [2]glx.Vec2{} {
+		// default value (if no specified)
+		uv[1] = glx.Vec2{X: 1, Y: 1}
+	}
+
+	tint := glx.ColorWhite.VecRGBA()
+	if p.TintUse {
+		tint = p.Tint.VecRGBA()
+	}
+
+	alpha := [4]glx.Vec1{{X: 1}, {X: 1}, {X: 1}, {X: 1}}
+	if p.AlphaUseGradient {
+		for i := range alpha {
+			alpha[i].X = glx.Clamp(p.AlphaGradient[i], 0, 1)
+		}
+	}
+
+	mode := vlk.DrawOptions{
+		PolygonMode: vulkan.PolygonModeFill,
+		BlendMode:   r.blendMode.toVLK(),
+		Texture:     p.Texture.id,
+	}
+
+	r.draw(buildInShaderTexture, mode, &shaderInputTexture2d{
+		vertexes: []shaderInputTexture2dVertex{
+			{pos: pos[0], color: tint, uv: glx.Vec2{X: uv[0].X, Y: uv[0].Y}, alpha: alpha[0]}, // tl
+			{pos: pos[1], color: tint, uv: glx.Vec2{X: uv[1].X, Y: uv[0].Y}, alpha: alpha[1]}, // tr
+			{pos: pos[2], color: tint, uv: glx.Vec2{X: uv[1].X, Y: uv[1].Y}, alpha: alpha[2]}, // br
+			{pos: pos[3], color: tint, uv: glx.Vec2{X: uv[0].X, Y: uv[1].Y}, alpha: alpha[3]}, // bl
 		},
-		thickness: glx.Vec1{X: glx.Clamp(p.HoleRadius, 0, 1)},
-		smooth:    glx.Vec1{X: glx.Clamp(p.Smooth, 0, 1)},
 	})
 }
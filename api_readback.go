@@ -0,0 +1,52 @@
+package vgl
+
+import (
+	"fmt"
+	"image"
+)
+
+// Screenshot will copy last rendered frame of main surface (window)
+// into image. Should be called after FrameEnd
+//
+// Window images can be read only when they copied before present,
+// this copy should be enabled by config.WithWindowCapture, or error
+// is returned. Image of frame is available only after its FrameEnd,
+// so call between FrameStart and FrameEnd return previous frame (one
+// frame latency). In headless mode capture is not required
+//
+// This is slow function, that wait until GPU finish
+// all current work
+func (r *Render) Screenshot() (*image.RGBA, error) {
+	return r.ReadSurface(SurfaceMain, image.Rectangle{})
+}
+
+// ReadSurface will copy surface pixels in rect into image. Empty rect
+// means whole surface. For main surface (window) last rendered frame
+// is used, for off-screen surfaces - current surface content
+//
+// Pixels of off-screen surfaces have premultiplied alpha (after default
+// blending). This is slow function, that wait until GPU finish
+// all current work
+func (r *Render) ReadSurface(id SurfaceID, rect image.Rectangle) (*image.RGBA, error) {
+	rect = rect.Canon()
+	if rect.Min.X < 0 || rect.Min.Y < 0 {
+		return nil, fmt.Errorf("failed read surface %d: invalid rect %s", id, rect)
+	}
+
+	pixels, width, height, err := r.api.ReadSurface(
+		uint8(id),
+		uint32(rect.Min.X),
+		uint32(rect.Min.Y),
+		uint32(rect.Dx()),
+		uint32(rect.Dy()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &image.RGBA{
+		Pix:    pixels,
+		Stride: int(width) * 4,
+		Rect:   image.Rect(0, 0, int(width), int(height)),
+	}, nil
+}
@@ -0,0 +1,151 @@
+package vgl
+
+import (
+	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/glx"
+	"github.com/go-glx/vgl/internal/gpu/vlk"
+)
+
+// SDF shapes is drawn as single quad with signed distance function
+// in buildIn.sdf shader, so edges is anti-aliased at any zoom/rotation.
+// Outline is drawn inside of shape edge, shadow is drawn below shape
+
+// Params2dRoundedRect is input for Draw2dRoundedRect
+type Params2dRoundedRect struct {
+	Pos          glx.Vec2   // pixel position of rect top,left corner (before rotation)
+	Size         glx.Vec2   // rect width and height in pixels
+	Radius       [4]float32 // corners radius in pixels (tl, tr, br, bl); max=half of smaller side
+	Rotation     glx.Angle  // default=0; rect rotation (radians) around its center
+	Color        glx.Color  // fill color (transparent color will draw only outline)
+	Gradient     *Gradient  // fill gradient (used instead of Color)
+	OutlineWidth float32    // default=0 (no outline); outline width in pixels
+	OutlineColor glx.Color  // outline color
+	ShadowOffset glx.Vec2   // shadow offset in pixels
+	ShadowBlur   float32    // default=0 (sharp); shadow blur radius in pixels
+	ShadowColor  glx.Color  // default=transparent (no shadow); shadow color
+	NoCulling    bool       // will send render command to GPU, even if all vertexes outside of visible screen
+}
+
+// Draw2dRoundedRect will draw rect with rounded corners on current surface with current blend mode
+func (r *Render) Draw2dRoundedRect(p *Params2dRoundedRect) {
+	if p.Size.X <= 0 || p.Size.Y <= 0 {
+		return
+	}
+
+	halfSize := p.Size.Scale(0.5)
+	shape := newSDFRoundedRect(p.Pos.Add(halfSize), halfSize, float64(p.Rotation), p.Radius)
+
+	r.drawSDF(&shape, &sdfStyle{
+		color:        p.Color.VecRGBA(),
+		gradient:     p.Gradient,
+		outlineWidth: p.OutlineWidth,
+		outlineColor: p.OutlineColor.VecRGBA(),
+		shadowOffset: p.ShadowOffset,
+		shadowBlur:   p.ShadowBlur,
+		shadowColor:  p.ShadowColor.VecRGBA(),
+	}, p.NoCulling)
+}
+
+// -----------------------------------------------------------------------------
+
+// Params2dCapsule is input for Draw2dCapsule
+type Params2dCapsule struct {
+	Pos          [2]glx.Vec2 // pixel positions of caps centers
+	Radius       float32     // capsule radius (half of width) in pixels
+	Color        glx.Color   // fill color (transparent color will draw only outline)
+	Gradient     *Gradient   // fill gradient (used instead of Color)
+	OutlineWidth float32     // default=0 (no outline); outline width in pixels
+	OutlineColor glx.Color   // outline color
+	ShadowOffset glx.Vec2    // shadow offset in pixels
+	ShadowBlur   float32     // default=0 (sharp); shadow blur radius in pixels
+	ShadowColor  glx.Color   // default=transparent (no shadow); shadow color
+	NoCulling    bool        // will send render command to GPU, even if all vertexes outside of visible screen
+}
+
+// Draw2dCapsule will draw capsule (line segment with round caps) on current surface with current blend mode
+func (r *Render) Draw2dCapsule(p *Params2dCapsule) {
+	if p.Radius <= 0 {
+		return
+	}
+
+	shape := newSDFCapsule(p.Pos[0], p.Pos[1], p.Radius)
+
+	r.drawSDF(&shape, &sdfStyle{
+		color:        p.Color.VecRGBA(),
+		gradient:     p.Gradient,
+		outlineWidth: p.OutlineWidth,
+		outlineColor: p.OutlineColor.VecRGBA(),
+		shadowOffset: p.ShadowOffset,
+		shadowBlur:   p.ShadowBlur,
+		shadowColor:  p.ShadowColor.VecRGBA(),
+	}, p.NoCulling)
+}
+
+// -----------------------------------------------------------------------------
+
+// Params2dNgon is input for Draw2dNgon
+type Params2dNgon struct {
+	PosCenter    glx.Vec2  // pixel position of ngon center
+	Radius       float32   // circumscribed circle radius in pixels
+	Sides        int       // default=6; min=3; count of sides
+	Rotation     glx.Angle // default=0 (first vertex pointing up); rotation (radians) around center
+	CornerRadius float32   // default=0 (sharp corners); corners rounding radius in pixels
+	Color        glx.Color // fill color (transparent color will draw only outline)
+	Gradient     *Gradient // fill gradient (used instead of Color)
+	OutlineWidth float32   // default=0 (no outline); outline width in pixels
+	OutlineColor glx.Color // outline color
+	ShadowOffset glx.Vec2  // shadow offset in pixels
+	ShadowBlur   float32   // default=0 (sharp); shadow blur radius in pixels
+	ShadowColor  glx.Color // default=transparent (no shadow); shadow color
+	NoCulling    bool      // will send render command to GPU, even if all vertexes outside of visible screen
+}
+
+// Draw2dNgon will draw regular polygon on current surface with current blend mode
+func (r *Render) Draw2dNgon(p *Params2dNgon) {
+	if p.Radius <= 0 {
+		return
+	}
+
+	shape := newSDFNgon(p.PosCenter, p.Radius, p.Sides, float64(p.Rotation), p.CornerRadius)
+
+	r.drawSDF(&shape, &sdfStyle{
+		color:        p.Color.VecRGBA(),
+		gradient:     p.Gradient,
+		outlineWidth: p.OutlineWidth,
+		outlineColor: p.OutlineColor.VecRGBA(),
+		shadowOffset: p.ShadowOffset,
+		shadowBlur:   p.ShadowBlur,
+		shadowColor:  p.ShadowColor.VecRGBA(),
+	}, p.NoCulling)
+}
+
+// -----------------------------------------------------------------------------
+
+func (r *Render) drawSDF(shape *sdfShape, style *sdfStyle, noCulling bool) {
+	if style.color.W <= 0 && style.gradient == nil && style.outlineColor.W <= 0 && style.shadowColor.W <= 0 {
+		return
+	}
+
+	// one screen pixel for anti-aliasing
+	margin := 1 / r.screenScale()
+	input := shape.shaderInput(style, margin)
+
+	if !noCulling {
+		pos := [4]glx.Vec2{}
+		for i, vertex := range input.vertexes {
+			pos[i] = vertex.pos
+		}
+
+		if !r.cullingRect(pos) {
+			return
+		}
+	}
+
+	mode := vlk.DrawOptions{
+		PolygonMode: vulkan.PolygonModeFill,
+		BlendMode:   r.blendMode.toVLK(),
+	}
+
+	r.draw(buildInShaderSDF, mode, input)
+}
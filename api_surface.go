@@ -1,8 +1,108 @@
 package vgl
 
+import (
+	"fmt"
+)
+
+// SurfaceID is ID of drawing surface
+// 0 - main surface (window), 1-255 off-screen surfaces
+type SurfaceID uint8
+
+// SurfaceMain is default surface, that will be presented on screen
+const SurfaceMain SurfaceID = 0
+
+// Surface is off-screen render target, created with Render.CreateSurface
+//
+// All drawings after Render.SetSurface(surface.ID()) will be rendered
+// into surface image, instead of screen. Surface image is also a Texture,
+// so it can be drawn with Draw2dTexture on other surfaces in the same
+// frame (minimaps, cached UI panels, effect layers, etc..)
+//
+// Surface is cleared to transparent color on first drawing in frame
+// when nothing is drawn into surface, it keep previous content
+//
+// Surface pixels have premultiplied alpha (after default blending),
+// so BlendModePremultiplied is good choice for drawing surface texture
+type Surface struct {
+	id      SurfaceID
+	texture *Texture
+}
+
+// ID returns surface ID, that can be used in Render.SetSurface
+func (s *Surface) ID() SurfaceID {
+	return s.id
+}
+
+// Texture returns surface image, that can be used in Draw2dTexture
+func (s *Surface) Texture() *Texture {
+	return s.texture
+}
+
+// Size returns surface size in pixels
+func (s *Surface) Size() (width float32, height float32) {
+	return s.texture.Size()
+}
+
 // SurfaceSize will return size of current surface
 // when current surface is 0 (default), it will return window size
 // this is fast function, you can call it many times per frame
 func (r *Render) SurfaceSize() (width float32, height float32) {
 	return r.api.GetSurfaceSize()
 }
+
+// CurrentSurface returns ID of current surface
+func (r *Render) CurrentSurface() SurfaceID {
+	return SurfaceID(r.api.CurrentSurface())
+}
+
+// CreateSurface will create off-screen surface with specified size
+// in pixels. Up to 255 surfaces can be created at the same time
+//
+// Surface will live until FreeSurface is called, or Render closed
+func (r *Render) CreateSurface(width, height int) (*Surface, error) {
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("failed create surface: invalid size (%dx%d)", width, height)
+	}
+
+	id := r.api.CreateSurface(uint32(width), uint32(height))
+	if id == 0 {
+		return nil, fmt.Errorf("failed create surface %dx%d (see render logs for details)", width, height)
+	}
+
+	r.cameras[id] = Camera2D{}
+
+	return &Surface{
+		id: SurfaceID(id),
+		texture: &Texture{
+			id:     r.api.SurfaceTexture(id),
+			width:  float32(width),
+			height: float32(height),
+		},
+	}, nil
+}
+
+// FreeSurface will release GPU memory of surface
+// surface (and its texture) cannot be used after this call
+//
+// When surface is current, main surface will be set as current
+//
+// This is slow function, that wait until GPU finish
+// all current work
+func (r *Render) FreeSurface(s *Surface) {
+	if s == nil || s.id == SurfaceMain {
+		return
+	}
+
+	r.api.FreeSurface(uint8(s.id))
+	s.id = SurfaceMain
+	s.texture.id = 0
+}
+
+// SetSurface will set current surface, all next drawings
+// will be rendered into it, until surface is changed again
+//
+// Each surface has own Camera2D, that can be set with SetCamera2D,
+// after surface is switched
+func (r *Render) SetSurface(id SurfaceID) error {
+	return r.api.SetSurface(uint8(id))
+}
@@ -0,0 +1,177 @@
+package vgl
+
+import (
+	"math"
+	"sort"
+
+	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/glx"
+	"github.com/go-glx/vgl/internal/gpu/vlk"
+)
+
+const (
+	TextAlignLeft   TextAlign = iota // lines aligned by left side of text box
+	TextAlignCenter                  // lines centered in text box
+	TextAlignRight                   // lines aligned by right side of text box
+)
+
+// TextAlign define horizontal lines alignment in text box. Text box
+// width is Params2dText.MaxWidth, or widest line width (if no MaxWidth)
+type TextAlign uint8
+
+// Params2dText is input for Draw2dText and MeasureText
+type Params2dText struct {
+	Font       *Font     // font loaded with Render.LoadFont
+	Text       string    // UTF-8 text, can contain new lines
+	Pos        glx.Vec2  // pixel position of text box top,left corner from top,left corner of surface
+	Color      glx.Color // text color (multiplied with glyph pixels)
+	Size       float32   // default=font native size; font size in pixels
+	MaxWidth   float32   // default=0 (no wrapping); words will be wrapped to next line, when line is wider
+	Align      TextAlign // default=TextAlignLeft
+	LineHeight float32   // default=1; multiplier of font line height
+	Rotation   glx.Angle // default=0; text box rotation (radians) around Pos
+	NoCulling  bool      // will send render command to GPU, even if all vertexes outside of visible screen
+
+	// MSDF fonts only (see Render.LoadFontMSDF), ignored for bitmap fonts
+
+	OutlineWidth   float32   // default=0 (no outline); outline width in pixels
+	OutlineColor   glx.Color // outline color
+	ShadowOffset   glx.Vec2  // shadow offset in pixels
+	ShadowSoftness float32   // default=0 (sharp); shadow blur radius in pixels
+	ShadowColor    glx.Color // default=transparent (no shadow); shadow color
+}
+
+// Draw2dText will draw text on current surface with current blend mode
+// glyphs of every font page is drawn in single draw group
+func (r *Render) Draw2dText(p *Params2dText) {
+	if p.Font == nil || len(p.Font.pages) == 0 || p.Text == "" {
+		return
+	}
+
+	layout := layoutText(p.Font, p.Text, p.layoutParams())
+	if len(layout.quads) == 0 {
+		return
+	}
+
+	if !p.NoCulling {
+		width := layout.width
+		if p.MaxWidth > width {
+			width = p.MaxWidth
+		}
+
+		if !r.cullingRect([4]glx.Vec2{
+			p.transform(glx.Vec2{}),
+			p.transform(glx.Vec2{X: width}),
+			p.transform(glx.Vec2{X: width, Y: layout.height}),
+			p.transform(glx.Vec2{Y: layout.height}),
+		}) {
+			return
+		}
+	}
+
+	// glyphs with same texture is grouped by GPU
+	// pipe, only when drawn one after another
+	sort.SliceStable(layout.quads, func(i, j int) bool {
+		return layout.quads[i].page < layout.quads[j].page
+	})
+
+	if p.Font.msdf {
+		r.drawTextMSDF(p, layout)
+		return
+	}
+
+	tint := p.Color.VecRGBA()
+	alpha := glx.Vec1{X: 1}
+
+	for _, quad := range layout.quads {
+		page := p.Font.pages[quad.page]
+		if page == nil || page.id == 0 {
+			continue
+		}
+
+		mode := vlk.DrawOptions{
+			PolygonMode: vulkan.PolygonModeFill,
+			BlendMode:   r.blendMode.toVLK(),
+			Texture:     page.id,
+		}
+
+		uv := quad.uv
+		r.draw(buildInShaderTexture, mode, &shaderInputTexture2d{
+			vertexes: []shaderInputTexture2dVertex{
+				{pos: p.transform(quad.pos[0]), color: tint, uv: glx.Vec2{X: uv[0].X, Y: uv[0].Y}, alpha: alpha}, // tl
+				{pos: p.transform(quad.pos[1]), color: tint, uv: glx.Vec2{X: uv[1].X, Y: uv[0].Y}, alpha: alpha}, // tr
+				{pos: p.transform(quad.pos[2]), color: tint, uv: glx.Vec2{X: uv[1].X, Y: uv[1].Y}, alpha: alpha}, // br
+				{pos: p.transform(quad.pos[3]), color: tint, uv: glx.Vec2{X: uv[0].X, Y: uv[1].Y}, alpha: alpha}, // bl
+			},
+		})
+	}
+}
+
+func (r *Render) drawTextMSDF(p *Params2dText, layout textLayout) {
+	tint := p.Color.VecRGBA()
+	outlineColor := p.OutlineColor.VecRGBA()
+	shadowColor := p.ShadowColor.VecRGBA()
+
+	for _, quad := range layout.quads {
+		page := p.Font.pages[quad.page]
+		if page == nil || page.id == 0 {
+			continue
+		}
+
+		mode := vlk.DrawOptions{
+			PolygonMode: vulkan.PolygonModeFill,
+			BlendMode:   r.blendMode.toVLK(),
+			Texture:     page.id,
+		}
+
+		uv := quad.uv
+		r.draw(buildInShaderMSDF, mode, &shaderInputMSDF2d{
+			vertexes: []shaderInputMSDF2dVertex{
+				{pos: p.transform(quad.pos[0]), color: tint, uv: glx.Vec2{X: uv[0].X, Y: uv[0].Y}}, // tl
+				{pos: p.transform(quad.pos[1]), color: tint, uv: glx.Vec2{X: uv[1].X, Y: uv[0].Y}}, // tr
+				{pos: p.transform(quad.pos[2]), color: tint, uv: glx.Vec2{X: uv[1].X, Y: uv[1].Y}}, // br
+				{pos: p.transform(quad.pos[3]), color: tint, uv: glx.Vec2{X: uv[0].X, Y: uv[1].Y}}, // bl
+			},
+			outlineColor:  outlineColor,
+			shadowColor:   shadowColor,
+			distanceRange: p.Font.distanceRange,
+			outlineWidth:  p.OutlineWidth,
+			shadowSoft:    p.ShadowSoftness,
+			shadowOffset:  p.ShadowOffset,
+		})
+	}
+}
+
+// MeasureText returns size of text box in pixels, that will
+// be drawn with same params by Draw2dText (Pos is ignored)
+func (r *Render) MeasureText(p *Params2dText) (width float32, height float32) {
+	if p.Font == nil || p.Text == "" {
+		return 0, 0
+	}
+
+	layout := layoutText(p.Font, p.Text, p.layoutParams())
+	return layout.width, layout.height
+}
+
+func (p *Params2dText) layoutParams() textLayoutParams {
+	return textLayoutParams{
+		size:       p.Size,
+		maxWidth:   p.MaxWidth,
+		lineHeight: p.LineHeight,
+		align:      p.Align,
+	}
+}
+
+// transform convert point from text box space to surface space
+func (p *Params2dText) transform(point glx.Vec2) glx.Vec2 {
+	if p.Rotation == 0 {
+		return p.Pos.Add(point)
+	}
+
+	sin, cos := math.Sincos(float64(p.Rotation))
+	return p.Pos.Add(glx.Vec2{
+		X: point.X*float32(cos) - point.Y*float32(sin),
+		Y: point.X*float32(sin) + point.Y*float32(cos),
+	})
+}
@@ -0,0 +1,98 @@
+package vgl
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+
+	"github.com/go-glx/vgl/internal/gpu/vlk"
+)
+
+const (
+	TextureFilterLinear  TextureFilter = iota // default; smooth interpolation between pixels
+	TextureFilterNearest                      // nearest pixel, good for pixel art and bitmap fonts
+)
+
+type (
+	// Texture is image uploaded to GPU memory
+	// can be created with Render.CreateTexture
+	Texture struct {
+		id     vlk.TextureID
+		width  float32
+		height float32
+	}
+
+	// TextureFilter define how texture pixels are sampled, when
+	// texture is drawn scaled (not in its pixel size)
+	TextureFilter uint8
+)
+
+// Size returns texture size in pixels
+func (t *Texture) Size() (width float32, height float32) {
+	return t.width, t.height
+}
+
+// CreateTexture will upload image to GPU memory
+// and return Texture that can be used in Draw2dTexture
+//
+// This is slow function, that wait until all data is copied
+// to GPU, so good idea is to create all textures once on
+// application start (or level loading)
+//
+// Texture will live until FreeTexture is called, or Render closed
+func (r *Render) CreateTexture(img image.Image) (*Texture, error) {
+	return r.CreateTextureWithFilter(img, TextureFilterLinear)
+}
+
+// CreateTextureWithFilter is same as CreateTexture, but texture
+// will be sampled with specified filter, when drawn scaled
+func (r *Render) CreateTextureWithFilter(img image.Image, filter TextureFilter) (*Texture, error) {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("failed create texture: image is empty (%dx%d)", width, height)
+	}
+
+	// GPU expect tightly packed RGBA pixels with not
+	// premultiplied alpha (same as default blending)
+	pixels, ok := img.(*image.NRGBA)
+	if !ok || pixels.Stride != width*4 || bounds.Min != (image.Point{}) {
+		pixels = image.NewNRGBA(image.Rect(0, 0, width, height))
+		draw.Draw(pixels, pixels.Bounds(), img, bounds.Min, draw.Src)
+	}
+
+	id := r.api.CreateTexture(pixels.Pix[:width*height*4], uint32(width), uint32(height), filter.toVLK())
+	if id == 0 {
+		return nil, fmt.Errorf("failed create texture %dx%d (see render logs for details)", width, height)
+	}
+
+	return &Texture{
+		id:     id,
+		width:  float32(width),
+		height: float32(height),
+	}, nil
+}
+
+// FreeTexture will release GPU memory of texture
+// texture cannot be used for drawing after this call
+//
+// This is slow function, that wait until GPU finish
+// all current work
+func (r *Render) FreeTexture(t *Texture) {
+	if t == nil || t.id == 0 {
+		return
+	}
+
+	r.api.FreeTexture(t.id)
+	t.id = 0
+}
+
+func (f TextureFilter) toVLK() vlk.TextureFilter {
+	switch f {
+	case TextureFilterNearest:
+		return vlk.TextureFilterNearest
+	default:
+		return vlk.TextureFilterLinear
+	}
+}
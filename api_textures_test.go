@@ -0,0 +1,68 @@
+package vgl_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-glx/glx"
+	"github.com/go-glx/vgl"
+	"github.com/go-glx/vgl/vgltest"
+)
+
+func TestTexture_Filter(t *testing.T) {
+	const size = 64
+
+	h := vgltest.New(t, vgltest.WithSize(size, size))
+
+	// 2x1 texture (red, blue) scaled to whole surface, so
+	// pixels near middle is between two texture pixels
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.SetNRGBA(0, 0, color.NRGBA{R: 255, A: 255})
+	img.SetNRGBA(1, 0, color.NRGBA{B: 255, A: 255})
+
+	tests := []struct {
+		name   string
+		filter vgl.TextureFilter
+		sharp  bool
+	}{
+		{name: "linear", filter: vgl.TextureFilterLinear, sharp: false},
+		{name: "nearest", filter: vgl.TextureFilterNearest, sharp: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tex, err := h.Render().CreateTextureWithFilter(img, tt.filter)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			defer h.Render().FreeTexture(tex)
+
+			got, err := h.Frames(2, func(rnd *vgl.Render) {
+				rnd.Draw2dTexture(&vgl.Params2dTexture{
+					Texture:          tex,
+					PosCenter:        glx.Vec2{X: size / 2, Y: size / 2},
+					PosSize:          glx.Vec2{X: size, Y: size},
+					PosUseCenterSize: true,
+				})
+			})
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			left, right := got.RGBAAt(size/2-4, size/2), got.RGBAAt(size/2+3, size/2)
+
+			if tt.sharp {
+				assert.Equal(t, color.RGBA{R: 255, A: 255}, left)
+				assert.Equal(t, color.RGBA{B: 255, A: 255}, right)
+				return
+			}
+
+			assert.True(t, left.R > 0 && left.B > 0, "left %v should be mixed", left)
+			assert.True(t, right.R > 0 && right.B > 0, "right %v should be mixed", right)
+		})
+	}
+}
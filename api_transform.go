@@ -0,0 +1,32 @@
+package vgl
+
+// PushTransform will multiply current transform by t, all next 2d drawings
+// (positions, sizes, culling) will be transformed, until PopTransform.
+// Transforms is nested: t is applied in local space of previous transform
+//
+// For example, nested UI panel:
+//
+//	r.PushTransform(Transform2D{Position: panelPos})
+//	r.Draw2dRect(...) // positions relative to panel
+//	r.PopTransform()
+//
+// Transform stack is reset on FrameStart
+func (r *Render) PushTransform(t Transform2D) {
+	r.transforms = append(r.transforms, r.transform)
+	r.transform = r.transform.mul(t.matrix())
+}
+
+// PopTransform will restore transform, that was before last PushTransform
+func (r *Render) PopTransform() {
+	if len(r.transforms) == 0 {
+		return
+	}
+
+	r.transform = r.transforms[len(r.transforms)-1]
+	r.transforms = r.transforms[:len(r.transforms)-1]
+}
+
+func (r *Render) resetTransform() {
+	r.transform = transform2dIdentity
+	r.transforms = r.transforms[:0]
+}
@@ -0,0 +1,75 @@
+package headless
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/vulkan-go/vulkan"
+)
+
+type (
+	// Headless is window manager without any real window. It should be
+	// used with config.WithHeadless(true), so renderer will draw frames
+	// into off-screen images. Vulkan library is loaded from system
+	// default location, so it works with any ICD, including software
+	// implementations (like lavapipe)
+	Headless struct {
+		appName    string
+		engineName string
+		width      int
+		height     int
+	}
+)
+
+func NewHeadless(
+	appName string,
+	engineName string,
+	width int,
+	height int,
+) *Headless {
+	return &Headless{
+		appName:    appName,
+		engineName: engineName,
+		width:      width,
+		height:     height,
+	}
+}
+
+func (h *Headless) AppName() string {
+	return h.appName
+}
+
+func (h *Headless) EngineName() string {
+	return h.engineName
+}
+
+func (h *Headless) OnWindowResized(_ func(width int, height int)) {
+	// size of headless frames is fixed
+}
+
+func (h *Headless) CreateSurface(_ vulkan.Instance) (vulkan.Surface, error) {
+	return nil, fmt.Errorf("headless window manager not have surface, renderer should be configured with config.WithHeadless(true)")
+}
+
+func (h *Headless) GetRequiredInstanceExtensions() []string {
+	return nil
+}
+
+func (h *Headless) GetFramebufferSize() (width, height int) {
+	return h.width, h.height
+}
+
+func (h *Headless) InitVulkanProcAddr() unsafe.Pointer {
+	err := vulkan.SetDefaultGetInstanceProcAddr()
+	if err != nil {
+		panic(fmt.Errorf("failed load vulkan library: %w", err))
+	}
+
+	// proc addr is set internally in vulkan package,
+	// and not exposed by default loader
+	return nil
+}
+
+func (h *Headless) Close() error {
+	return nil
+}
@@ -0,0 +1,54 @@
+package headless
+
+import (
+	"fmt"
+
+	"github.com/vulkan-go/vulkan"
+)
+
+// Available will check that vulkan library can be loaded and system
+// has at least one vulkan device, so headless renderer can be created.
+// This is useful for tests, that should be skipped on machines
+// without vulkan
+func Available() error {
+	err := vulkan.SetDefaultGetInstanceProcAddr()
+	if err != nil {
+		return fmt.Errorf("failed load vulkan library: %w", err)
+	}
+
+	err = vulkan.Init()
+	if err != nil {
+		return fmt.Errorf("failed init vulkan: %w", err)
+	}
+
+	var inst vulkan.Instance
+	err = vulkan.Error(vulkan.CreateInstance(&vulkan.InstanceCreateInfo{
+		SType: vulkan.StructureTypeInstanceCreateInfo,
+		PApplicationInfo: &vulkan.ApplicationInfo{
+			SType:      vulkan.StructureTypeApplicationInfo,
+			ApiVersion: vulkan.MakeVersion(1, 0, 0),
+		},
+	}, nil, &inst))
+	if err != nil {
+		return fmt.Errorf("failed create vulkan instance: %w", err)
+	}
+
+	defer vulkan.DestroyInstance(inst, nil)
+
+	err = vulkan.InitInstance(inst)
+	if err != nil {
+		return fmt.Errorf("failed init vulkan instance: %w", err)
+	}
+
+	var count uint32
+	err = vulkan.Error(vulkan.EnumeratePhysicalDevices(inst, &count, nil))
+	if err != nil {
+		return fmt.Errorf("failed enumerate vulkan devices: %w", err)
+	}
+
+	if count == 0 {
+		return fmt.Errorf("vulkan devices not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package vgl
+
+import (
+	"math"
+
+	"github.com/go-glx/glx"
+)
+
+// Camera2D transform world pixel space into surface pixel space
+//
+// By default (zero value) camera is not change anything, world
+// position is equal to surface position
+//
+//	screen = Offset + rotate(Rotation) * Zoom * (world - Position)
+type Camera2D struct {
+	Position glx.Vec2  // world position, that camera is looking at
+	Offset   glx.Vec2  // surface position in pixels, where Position will be shown (for example center of surface)
+	Zoom     float32   // default=1; 2=everything is twice bigger; 0.5=twice smaller
+	Rotation glx.Angle // camera rotation in radians (clock-wise)
+}
+
+func (c *Camera2D) zoom() float32 {
+	if c.Zoom <= 0 {
+		// default value (if no specified)
+		return 1
+	}
+
+	return c.Zoom
+}
+
+func (c *Camera2D) rotation() (sin float32, cos float32) {
+	if c.Rotation == 0 {
+		return 0, 1
+	}
+
+	s, co := math.Sincos(float64(c.Rotation))
+	return float32(s), float32(co)
+}
+
+// worldToScreen transform world position to surface pixel position
+func (c *Camera2D) worldToScreen(pos glx.Vec2) glx.Vec2 {
+	zoom := c.zoom()
+	sin, cos := c.rotation()
+
+	x := (pos.X - c.Position.X) * zoom
+	y := (pos.Y - c.Position.Y) * zoom
+
+	return glx.Vec2{
+		X: c.Offset.X + x*cos - y*sin,
+		Y: c.Offset.Y + x*sin + y*cos,
+	}
+}
+
+// screenToWorld transform surface pixel position to world position
+func (c *Camera2D) screenToWorld(pos glx.Vec2) glx.Vec2 {
+	zoom := c.zoom()
+	sin, cos := c.rotation()
+
+	x := pos.X - c.Offset.X
+	y := pos.Y - c.Offset.Y
+
+	return glx.Vec2{
+		X: c.Position.X + (x*cos+y*sin)/zoom,
+		Y: c.Position.Y + (-x*sin+y*cos)/zoom,
+	}
+}
+
+// viewMatrix returns column-major mat4 of camera transform
+// (same transformation as worldToScreen)
+func (c *Camera2D) viewMatrix() [16]float32 {
+	zoom := c.zoom()
+	sin, cos := c.rotation()
+
+	// linear part (rotate * zoom)
+	a, b := zoom*cos, -zoom*sin
+	cc, d := zoom*sin, zoom*cos
+
+	// translation part
+	tx := c.Offset.X - (a*c.Position.X + b*c.Position.Y)
+	ty := c.Offset.Y - (cc*c.Position.X + d*c.Position.Y)
+
+	return [16]float32{
+		a, cc, 0, 0,
+		b, d, 0, 0,
+		0, 0, 1, 0,
+		tx, ty, 0, 1,
+	}
+}
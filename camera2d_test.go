@@ -0,0 +1,61 @@
+package vgl
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-glx/glx"
+)
+
+func TestCamera2D_Transform(t *testing.T) {
+	tests := []struct {
+		name   string
+		camera Camera2D
+		world  glx.Vec2
+		screen glx.Vec2
+	}{
+		{
+			name:   "default camera not change positions",
+			camera: Camera2D{},
+			world:  glx.Vec2{X: 10, Y: 20},
+			screen: glx.Vec2{X: 10, Y: 20},
+		},
+		{
+			name:   "scroll",
+			camera: Camera2D{Position: glx.Vec2{X: 100, Y: 50}},
+			world:  glx.Vec2{X: 110, Y: 70},
+			screen: glx.Vec2{X: 10, Y: 20},
+		},
+		{
+			name:   "zoom around offset",
+			camera: Camera2D{Position: glx.Vec2{X: 100, Y: 100}, Offset: glx.Vec2{X: 320, Y: 240}, Zoom: 2},
+			world:  glx.Vec2{X: 110, Y: 90},
+			screen: glx.Vec2{X: 340, Y: 220},
+		},
+		{
+			name:   "rotate clock-wise",
+			camera: Camera2D{Offset: glx.Vec2{X: 50, Y: 50}, Rotation: math.Pi / 2},
+			world:  glx.Vec2{X: 10, Y: 0},
+			screen: glx.Vec2{X: 50, Y: 60},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			screen := tt.camera.worldToScreen(tt.world)
+			assert.InDelta(t, tt.screen.X, screen.X, 0.001)
+			assert.InDelta(t, tt.screen.Y, screen.Y, 0.001)
+
+			world := tt.camera.screenToWorld(screen)
+			assert.InDelta(t, tt.world.X, world.X, 0.001)
+			assert.InDelta(t, tt.world.Y, world.Y, 0.001)
+
+			// GPU view matrix should do same transformation
+			m := tt.camera.viewMatrix()
+			assert.InDelta(t, tt.screen.X, m[0]*tt.world.X+m[4]*tt.world.Y+m[12], 0.001)
+			assert.InDelta(t, tt.screen.Y, m[1]*tt.world.X+m[5]*tt.world.Y+m[13], 0.001)
+		})
+	}
+}
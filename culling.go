@@ -0,0 +1,180 @@
+package vgl
+
+import (
+	"math"
+
+	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/glx"
+)
+
+// cullingMargin is extra space in pixels around surface, where
+// objects still can be visible (line width, circle smoothing, etc..)
+const cullingMargin = 1
+
+// surfaceBox is axis aligned rect of visible surface area
+// in surface pixel space
+type surfaceBox struct {
+	min glx.Vec2
+	max glx.Vec2
+}
+
+func newSurfaceBox(width, height float32) surfaceBox {
+	return surfaceBox{
+		min: glx.Vec2{X: -cullingMargin, Y: -cullingMargin},
+		max: glx.Vec2{X: width + cullingMargin, Y: height + cullingMargin},
+	}
+}
+
+// newClipBox returns bounding box of screen points, aligned
+// to pixels grid (partially covered pixels is included)
+func newClipBox(vert [4]glx.Vec2) surfaceBox {
+	min, max := pointsBounds(vert[:])
+
+	return surfaceBox{
+		min: glx.Vec2{X: float32(math.Floor(float64(min.X))), Y: float32(math.Floor(float64(min.Y)))},
+		max: glx.Vec2{X: float32(math.Ceil(float64(max.X))), Y: float32(math.Ceil(float64(max.Y)))},
+	}
+}
+
+// intersect returns common area of two boxes, or
+// empty box, when boxes is not intersected
+func (b surfaceBox) intersect(other surfaceBox) surfaceBox {
+	res := surfaceBox{
+		min: glx.Vec2{X: maxf(b.min.X, other.min.X), Y: maxf(b.min.Y, other.min.Y)},
+		max: glx.Vec2{X: minf(b.max.X, other.max.X), Y: minf(b.max.Y, other.max.Y)},
+	}
+
+	if res.empty() {
+		res.max = res.min
+	}
+
+	return res
+}
+
+func (b surfaceBox) empty() bool {
+	return b.max.X <= b.min.X || b.max.Y <= b.min.Y
+}
+
+// scissor returns box as vulkan scissor rect, box
+// part with negative coords is cut off
+func (b surfaceBox) scissor() vulkan.Rect2D {
+	box := b.intersect(surfaceBox{max: glx.Vec2{X: math.MaxInt32, Y: math.MaxInt32}})
+
+	return vulkan.Rect2D{
+		Offset: vulkan.Offset2D{X: int32(box.min.X), Y: int32(box.min.Y)},
+		Extent: vulkan.Extent2D{Width: uint32(box.max.X - box.min.X), Height: uint32(box.max.Y - box.min.Y)},
+	}
+}
+
+// clipScissor returns clip as scissor rect on surface with specified
+// size. False is returned, when clip not cover any surface pixel, so
+// nothing can be drawn in it (zero scissor is not used for this,
+// because zero clip means "not clipped" in draw options)
+func clipScissor(clip surfaceBox, width, height float32) (vulkan.Rect2D, bool) {
+	box := clip.intersect(surfaceBox{max: glx.Vec2{X: width, Y: height}})
+	if box.empty() {
+		return vulkan.Rect2D{}, false
+	}
+
+	return box.scissor(), true
+}
+
+func (b surfaceBox) containsPoint(p glx.Vec2) bool {
+	return p.X >= b.min.X && p.X <= b.max.X && p.Y >= b.min.Y && p.Y <= b.max.Y
+}
+
+// intersectsPolygon check that convex polygon (or segment, when
+// only two vertexes given) is intersected with box, with
+// separating axis theorem (SAT)
+//
+// For not convex polygons result is conservative: polygon can
+// be reported as visible, but never culled when it visible
+func (b surfaceBox) intersectsPolygon(vert []glx.Vec2) bool {
+	if len(vert) == 0 {
+		return false
+	}
+
+	// box axes (x, y), this is same as AABB test
+	polyMin, polyMax := vert[0], vert[0]
+	for _, v := range vert[1:] {
+		polyMin.X, polyMax.X = minf(polyMin.X, v.X), maxf(polyMax.X, v.X)
+		polyMin.Y, polyMax.Y = minf(polyMin.Y, v.Y), maxf(polyMax.Y, v.Y)
+	}
+
+	if polyMax.X < b.min.X || polyMin.X > b.max.X || polyMax.Y < b.min.Y || polyMin.Y > b.max.Y {
+		return false
+	}
+
+	// polygon edges normals
+	corners := [4]glx.Vec2{
+		b.min,
+		{X: b.max.X, Y: b.min.Y},
+		b.max,
+		{X: b.min.X, Y: b.max.Y},
+	}
+
+	edges := len(vert)
+	if edges == 2 {
+		// segment has only one edge
+		edges = 1
+	}
+
+	for i := 0; i < edges; i++ {
+		edge := vert[(i+1)%len(vert)].Sub(vert[i])
+		axis := glx.Vec2{X: -edge.Y, Y: edge.X}
+
+		if axis.X == 0 && axis.Y == 0 {
+			// degenerate edge
+			continue
+		}
+
+		pMin, pMax := projectOnAxis(axis, vert)
+		bMin, bMax := projectOnAxis(axis, corners[:])
+
+		if pMax < bMin || pMin > bMax {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (b surfaceBox) intersectsCircle(center glx.Vec2, radius float32) bool {
+	// distance from center to closest box point
+	closest := glx.Vec2{
+		X: glx.Clamp(center.X, b.min.X, b.max.X),
+		Y: glx.Clamp(center.Y, b.min.Y, b.max.Y),
+	}
+
+	dist := center.Sub(closest)
+	return dist.X*dist.X+dist.Y*dist.Y <= radius*radius
+}
+
+func projectOnAxis(axis glx.Vec2, vert []glx.Vec2) (min float32, max float32) {
+	min = axis.X*vert[0].X + axis.Y*vert[0].Y
+	max = min
+
+	for _, v := range vert[1:] {
+		proj := axis.X*v.X + axis.Y*v.Y
+		min, max = minf(min, proj), maxf(max, proj)
+	}
+
+	return min, max
+}
+
+func minf(a, b float32) float32 {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+func maxf(a, b float32) float32 {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,185 @@
+package vgl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/glx"
+)
+
+func TestSurfaceBox_IntersectsPolygon(t *testing.T) {
+	box := newSurfaceBox(100, 100)
+
+	tests := []struct {
+		name    string
+		vert    []glx.Vec2
+		visible bool
+	}{
+		// segments
+		{name: "line inside", vert: []glx.Vec2{{X: 10, Y: 10}, {X: 90, Y: 90}}, visible: true},
+		{name: "line cross surface", vert: []glx.Vec2{{X: -50, Y: 50}, {X: 150, Y: 50}}, visible: true},
+		{name: "line left", vert: []glx.Vec2{{X: -50, Y: 0}, {X: -10, Y: 100}}, visible: false},
+		{name: "line below", vert: []glx.Vec2{{X: 0, Y: 150}, {X: 100, Y: 120}}, visible: false},
+		{name: "line diagonal near corner", vert: []glx.Vec2{{X: 90, Y: 130}, {X: 130, Y: 90}}, visible: false},
+		{name: "line diagonal cross corner", vert: []glx.Vec2{{X: 60, Y: 130}, {X: 130, Y: 60}}, visible: true},
+		{name: "line on margin", vert: []glx.Vec2{{X: -1, Y: 10}, {X: -1, Y: 90}}, visible: true},
+		{name: "zero length line", vert: []glx.Vec2{{X: 50, Y: 50}, {X: 50, Y: 50}}, visible: true},
+
+		// triangles
+		{name: "triangle inside", vert: []glx.Vec2{{X: 10, Y: 10}, {X: 50, Y: 10}, {X: 10, Y: 50}}, visible: true},
+		{name: "triangle contains surface", vert: []glx.Vec2{{X: -500, Y: -500}, {X: 1000, Y: -500}, {X: -500, Y: 1000}}, visible: true},
+		{name: "triangle right", vert: []glx.Vec2{{X: 110, Y: 10}, {X: 150, Y: 10}, {X: 110, Y: 50}}, visible: false},
+		{name: "triangle aabb overlap only", vert: []glx.Vec2{{X: 90, Y: 130}, {X: 130, Y: 90}, {X: 150, Y: 150}}, visible: false},
+		{name: "triangle corner inside", vert: []glx.Vec2{{X: 90, Y: 90}, {X: 200, Y: 90}, {X: 90, Y: 200}}, visible: true},
+
+		// rects
+		{name: "rect inside", vert: []glx.Vec2{{X: 10, Y: 10}, {X: 20, Y: 10}, {X: 20, Y: 20}, {X: 10, Y: 20}}, visible: true},
+		{name: "rect above", vert: []glx.Vec2{{X: 10, Y: -30}, {X: 20, Y: -30}, {X: 20, Y: -20}, {X: 10, Y: -20}}, visible: false},
+		{name: "rect bigger than surface", vert: []glx.Vec2{{X: -10, Y: -10}, {X: 110, Y: -10}, {X: 110, Y: 110}, {X: -10, Y: 110}}, visible: true},
+		{name: "rotated rect near corner", vert: []glx.Vec2{{X: 115, Y: 105}, {X: 125, Y: 115}, {X: 115, Y: 125}, {X: 105, Y: 115}}, visible: false},
+		{name: "rotated rect over corner", vert: []glx.Vec2{{X: 100, Y: 90}, {X: 110, Y: 100}, {X: 100, Y: 110}, {X: 90, Y: 100}}, visible: true},
+
+		// degenerate
+		{name: "no vertexes", vert: []glx.Vec2{}, visible: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.visible, box.intersectsPolygon(tt.vert))
+		})
+	}
+}
+
+func TestSurfaceBox_IntersectsCircle(t *testing.T) {
+	box := newSurfaceBox(100, 100)
+
+	tests := []struct {
+		name    string
+		center  glx.Vec2
+		radius  float32
+		visible bool
+	}{
+		{name: "inside", center: glx.Vec2{X: 50, Y: 50}, radius: 10, visible: true},
+		{name: "contains surface", center: glx.Vec2{X: 50, Y: 50}, radius: 1000, visible: true},
+		{name: "center outside, edge inside", center: glx.Vec2{X: -10, Y: 50}, radius: 20, visible: true},
+		{name: "left", center: glx.Vec2{X: -30, Y: 50}, radius: 20, visible: false},
+		{name: "near corner, aabb overlap only", center: glx.Vec2{X: 120, Y: 120}, radius: 25, visible: false},
+		{name: "over corner", center: glx.Vec2{X: 110, Y: 110}, radius: 20, visible: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.visible, box.intersectsCircle(tt.center, tt.radius))
+		})
+	}
+}
+
+func TestSurfaceBox_ContainsPoint(t *testing.T) {
+	box := newSurfaceBox(100, 100)
+
+	tests := []struct {
+		name    string
+		point   glx.Vec2
+		visible bool
+	}{
+		{name: "inside", point: glx.Vec2{X: 50, Y: 50}, visible: true},
+		{name: "top-left corner", point: glx.Vec2{X: 0, Y: 0}, visible: true},
+		{name: "bottom-right corner", point: glx.Vec2{X: 100, Y: 100}, visible: true},
+		{name: "right", point: glx.Vec2{X: 102, Y: 50}, visible: false},
+		{name: "above", point: glx.Vec2{X: 50, Y: -2}, visible: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.visible, box.containsPoint(tt.point))
+		})
+	}
+}
+
+func TestSurfaceBox_Clip(t *testing.T) {
+	rect := func(x0, y0, x1, y1 float32) surfaceBox {
+		return surfaceBox{min: glx.Vec2{X: x0, Y: y0}, max: glx.Vec2{X: x1, Y: y1}}
+	}
+
+	tests := []struct {
+		name    string
+		a, b    surfaceBox
+		want    surfaceBox
+		empty   bool
+		scissor vulkan.Rect2D
+	}{
+		{
+			name:    "nested",
+			a:       rect(10, 10, 90, 90),
+			b:       rect(20, 0, 50, 40),
+			want:    rect(20, 10, 50, 40),
+			scissor: vulkan.Rect2D{Offset: vulkan.Offset2D{X: 20, Y: 10}, Extent: vulkan.Extent2D{Width: 30, Height: 30}},
+		},
+		{
+			name:    "negative coords is cut off",
+			a:       rect(-20, -10, 50, 40),
+			b:       rect(-50, -50, 100, 100),
+			want:    rect(-20, -10, 50, 40),
+			scissor: vulkan.Rect2D{Extent: vulkan.Extent2D{Width: 50, Height: 40}},
+		},
+		{
+			name:    "not intersected",
+			a:       rect(0, 0, 10, 10),
+			b:       rect(20, 20, 30, 30),
+			want:    rect(20, 20, 20, 20),
+			empty:   true,
+			scissor: vulkan.Rect2D{Offset: vulkan.Offset2D{X: 20, Y: 20}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.a.intersect(tt.b)
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.empty, got.empty())
+			assert.Equal(t, tt.scissor, got.scissor())
+		})
+	}
+
+	clip := newClipBox([4]glx.Vec2{{X: 10.5, Y: 5}, {X: 20.2, Y: 5}, {X: 20.2, Y: 15.7}, {X: 10.5, Y: 15.7}})
+	assert.Equal(t, rect(10, 5, 21, 16), clip)
+}
+
+func TestClipScissor(t *testing.T) {
+	rect := func(x0, y0, x1, y1 float32) surfaceBox {
+		return surfaceBox{min: glx.Vec2{X: x0, Y: y0}, max: glx.Vec2{X: x1, Y: y1}}
+	}
+
+	scissor := func(x, y int32, w, h uint32) vulkan.Rect2D {
+		return vulkan.Rect2D{Offset: vulkan.Offset2D{X: x, Y: y}, Extent: vulkan.Extent2D{Width: w, Height: h}}
+	}
+
+	tests := []struct {
+		name    string
+		clip    surfaceBox
+		scissor vulkan.Rect2D
+		visible bool
+	}{
+		{name: "inside", clip: rect(10, 20, 50, 60), scissor: scissor(10, 20, 40, 40), visible: true},
+		{name: "partially left", clip: rect(-20, 10, 30, 20), scissor: scissor(0, 10, 30, 10), visible: true},
+		{name: "partially right", clip: rect(90, 10, 120, 20), scissor: scissor(90, 10, 10, 10), visible: true},
+		{name: "whole surface", clip: rect(-50, -50, 500, 500), scissor: scissor(0, 0, 100, 80), visible: true},
+		{name: "empty", clip: rect(10, 10, 10, 10), visible: false},
+		{name: "fully left", clip: rect(-100, 10, -10, 50), visible: false},
+		{name: "fully above", clip: rect(10, -100, 50, -10), visible: false},
+		{name: "fully right", clip: rect(200, 10, 300, 50), visible: false},
+		{name: "fully below", clip: rect(10, 80, 50, 120), visible: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, visible := clipScissor(tt.clip, 100, 80)
+
+			assert.Equal(t, tt.visible, visible)
+			assert.Equal(t, tt.scissor, got)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package vgl
+
+import (
+	"math"
+
+	"github.com/go-glx/glx"
+)
+
+// ellipseQuad returns clock-wise (tl, tr, br, bl) bounding box of rotated
+// ellipse. Circle shader map this quad into [-1 .. 1] UV space
+func ellipseQuad(center glx.Vec2, radius glx.Vec2, rotation float64) [4]glx.Vec2 {
+	sin, cos := math.Sincos(rotation)
+	local := [4]glx.Vec2{
+		{X: -radius.X, Y: -radius.Y},
+		{X: +radius.X, Y: -radius.Y},
+		{X: +radius.X, Y: +radius.Y},
+		{X: -radius.X, Y: +radius.Y},
+	}
+
+	quad := [4]glx.Vec2{}
+	for i, point := range local {
+		quad[i] = center.Add(glx.Vec2{
+			X: point.X*float32(cos) - point.Y*float32(sin),
+			Y: point.X*float32(sin) + point.Y*float32(cos),
+		})
+	}
+
+	return quad
+}
+
+// arcAngles convert clock-wise arc from start to end angle into
+// middle angle and half of arc angle. Full is true, when arc
+// cover all circle (arc angle >= 360 deg)
+func arcAngles(start, end float64) (middle float64, half float64, full bool) {
+	const fullAngle = math.Pi * 2
+
+	span := end - start
+	if span >= fullAngle || span <= -fullAngle {
+		return 0, math.Pi, true
+	}
+
+	if span < 0 {
+		span += fullAngle
+	}
+
+	middle = math.Mod(start+span/2, fullAngle)
+	if middle > math.Pi {
+		middle -= fullAngle
+	}
+
+	if middle < -math.Pi {
+		middle += fullAngle
+	}
+
+	return middle, span / 2, false
+}
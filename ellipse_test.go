@@ -0,0 +1,64 @@
+package vgl
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-glx/glx"
+)
+
+func TestArcAngles(t *testing.T) {
+	tests := []struct {
+		name   string
+		start  float64
+		end    float64
+		middle float64
+		half   float64
+		full   bool
+	}{
+		{name: "quarter", start: 0, end: math.Pi / 2, middle: math.Pi / 4, half: math.Pi / 4},
+		{name: "progress from top", start: -math.Pi / 2, end: math.Pi / 2, middle: 0, half: math.Pi / 2},
+		{name: "end before start", start: math.Pi / 2, end: 0, middle: -math.Pi * 3 / 4, half: math.Pi * 3 / 4},
+		{name: "over 180 deg", start: math.Pi * 3 / 4, end: math.Pi * 5 / 4, middle: math.Pi, half: math.Pi / 4},
+		{name: "many turns", start: math.Pi * 4, end: math.Pi*4 + 0.5, middle: 0.25, half: 0.25},
+		{name: "empty", start: 1, end: 1, middle: 1, half: 0},
+		{name: "full", start: 1, end: 1 + math.Pi*2, half: math.Pi, full: true},
+		{name: "full reversed", start: 0, end: -math.Pi * 3, half: math.Pi, full: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			middle, half, full := arcAngles(tt.start, tt.end)
+
+			assert.Equal(t, tt.full, full)
+			assert.InDelta(t, tt.half, half, 0.0001)
+			assert.InDelta(t, math.Cos(tt.middle), math.Cos(middle), 0.0001)
+			assert.InDelta(t, math.Sin(tt.middle), math.Sin(middle), 0.0001)
+		})
+	}
+}
+
+func TestEllipseQuad(t *testing.T) {
+	quad := ellipseQuad(glx.Vec2{X: 100, Y: 50}, glx.Vec2{X: 20, Y: 10}, math.Pi/2)
+
+	// rotated 90 deg clock-wise: top-left corner is at right top
+	assert.InDelta(t, 110, quad[0].X, 0.0001)
+	assert.InDelta(t, 30, quad[0].Y, 0.0001)
+	assert.InDelta(t, 90, quad[2].X, 0.0001)
+	assert.InDelta(t, 70, quad[2].Y, 0.0001)
+
+	cross := (quad[1].X-quad[0].X)*(quad[2].Y-quad[0].Y) - (quad[1].Y-quad[0].Y)*(quad[2].X-quad[0].X)
+	assert.Greater(t, cross, float32(0))
+}
+
+func TestShaderInputStorageSize(t *testing.T) {
+	// storage data size should match std430 struct size
+	// in shader, or all instances after first is broken
+	circle := &shaderInputCircle2d{}
+	assert.Len(t, circle.StorageData(), 32, "Circle in circle2d.frag")
+
+	arc := &shaderInputCircle2d{mode: circleModeEllipse | circleModeArc | circleModePie, ringWidth: 2}
+	assert.Len(t, arc.StorageData(), 32, "Circle in circle2d.frag")
+}
@@ -11,6 +11,9 @@ type animData struct {
 
 var animDataMap map[any]*animData
 
+// animNow is animations clock, can be frozen in tests
+var animNow = time.Now
+
 func init() {
 	animDataMap = map[any]*animData{}
 }
@@ -18,19 +21,19 @@ func init() {
 func anim(key any, duration time.Duration, from float32, to float32) float32 {
 	if _, exist := animDataMap[key]; !exist {
 		animDataMap[key] = &animData{
-			next:    time.Now(),
+			next:    animNow(),
 			forward: false,
 		}
 	}
 
 	data := animDataMap[key]
 
-	if time.Now().After(data.next) {
-		data.next = time.Now().Add(duration)
+	if animNow().After(data.next) {
+		data.next = animNow().Add(duration)
 		data.forward = !data.forward
 	}
 
-	progress := float32(data.next.Sub(time.Now())) / float32(duration)
+	progress := float32(data.next.Sub(animNow())) / float32(duration)
 	if data.forward {
 		progress = 1 - progress
 	}
@@ -6,10 +6,27 @@ import (
 	"github.com/go-glx/vgl/shared/metrics"
 )
 
-var e1RenderOrderInv = false
-var e1SubscribeSwitch = false
+// e1RectDrawOrder will switch draw order every second, when
+// switchOrder is true (stats listener), or always use default order
+func e1RectDrawOrder(switchOrder bool) func(rnd *vgl.Render) {
+	renderOrderInv := false
+	subscribed := false
+
+	return func(rnd *vgl.Render) {
+		if switchOrder && !subscribed {
+			subscribed = true
+			rnd.ListenStats(func(stats metrics.Stats) {
+				if stats.FrameIndex == 0 {
+					renderOrderInv = !renderOrderInv
+				}
+			})
+		}
+
+		e1DrawRects(rnd, renderOrderInv)
+	}
+}
 
-func e1RectDrawOrder(rnd *vgl.Render) {
+func e1DrawRects(rnd *vgl.Render, renderOrderInv bool) {
 	const slotsCount = 10
 	const slotPaddingPercent = 1
 
@@ -30,15 +47,6 @@ func e1RectDrawOrder(rnd *vgl.Render) {
 	// 1_O  3_O  5_O  7_O
 	// 2_F  4_F  6_F  8_F
 
-	if !e1SubscribeSwitch {
-		e1SubscribeSwitch = true
-		rnd.ListenStats(func(stats metrics.Stats) {
-			if stats.FrameIndex == 0 {
-				e1RenderOrderInv = !e1RenderOrderInv
-			}
-		})
-	}
-
 	width, height := rnd.SurfaceSize()
 	slotWidth := glx.Floor(width / slotsCount)
 	slotHeight := glx.Floor(height / slotsCount)
@@ -50,7 +58,7 @@ func e1RectDrawOrder(rnd *vgl.Render) {
 
 			var filled bool
 
-			if e1RenderOrderInv {
+			if renderOrderInv {
 				// 2 draw-calls
 				filled = x >= width/2
 			} else {
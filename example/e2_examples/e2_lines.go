@@ -66,6 +66,24 @@ func e2Lines(rnd *vgl.Render) {
 			ColorUseGradient: true,
 			Width:            10,
 		},
+		{
+			// anti-aliased line
+			Color:       colGrayDark,
+			Antialiased: true,
+		},
+		{
+			// anti-aliased bold line with round caps
+			Color:       colGrayDark,
+			Width:       10,
+			Cap:         vgl.LineCapRound,
+			Antialiased: true,
+		},
+		{
+			// anti-aliased sub-pixel line (drawn with lower alpha)
+			Color:       colGrayDark,
+			Width:       0.5,
+			Antialiased: true,
+		},
 	}
 
 	for featInd, feature := range features {
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"time"
+
+	"github.com/go-glx/glx"
+	"github.com/go-glx/vgl"
+)
+
+type animTextureFade struct{}
+
+const e6CheckerSize = 64
+const e6CheckerCell = 8
+
+var e6Texture *vgl.Texture
+
+func e6Textures(rnd *vgl.Render) {
+	if e6Texture == nil {
+		// textures should be created once, not every frame
+		tex, err := rnd.CreateTexture(e6Checker())
+		if err != nil {
+			panic(err)
+		}
+
+		e6Texture = tex
+	}
+
+	w, h := rnd.SurfaceSize()
+	size := h / 4
+	fade := anim(animTextureFade{}, time.Second*3, 0, 1)
+
+	sprite := func(x, y float32) [4]glx.Vec2 {
+		return [4]glx.Vec2{{X: x, Y: y}, {X: x + size, Y: y}, {X: x + size, Y: y + size}, {X: x, Y: y + size}}
+	}
+
+	// full texture
+	rnd.Draw2dTexture(&vgl.Params2dTexture{
+		Texture: e6Texture,
+		Pos:     sprite(w*0.1, h*0.2),
+	})
+
+	// top-left quarter of texture (sprite sheet region)
+	rnd.Draw2dTexture(&vgl.Params2dTexture{
+		Texture: e6Texture,
+		Pos:     sprite(w*0.4, h*0.2),
+		UV:      [2]glx.Vec2{{X: 0, Y: 0}, {X: 0.5, Y: 0.5}},
+	})
+
+	// tinted
+	rnd.Draw2dTexture(&vgl.Params2dTexture{
+		Texture: e6Texture,
+		Pos:     sprite(w*0.7, h*0.2),
+		Tint:    colMain,
+		TintUse: true,
+	})
+
+	// per-vertex alpha
+	rnd.Draw2dTexture(&vgl.Params2dTexture{
+		Texture:          e6Texture,
+		Pos:              sprite(w*0.25, h*0.6),
+		AlphaGradient:    [4]float32{1, fade, 0, 1 - fade},
+		AlphaUseGradient: true,
+	})
+
+	rnd.Draw2dTexture(&vgl.Params2dTexture{
+		Texture:          e6Texture,
+		Pos:              sprite(w*0.55, h*0.6),
+		Tint:             colAccent,
+		TintUse:          true,
+		AlphaGradient:    [4]float32{fade, fade, fade, fade},
+		AlphaUseGradient: true,
+	})
+}
+
+func e6Checker() image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, e6CheckerSize, e6CheckerSize))
+
+	for y := 0; y < e6CheckerSize; y++ {
+		for x := 0; x < e6CheckerSize; x++ {
+			col := color.NRGBA{R: 255, G: 255, B: 255, A: 255}
+			if ((x/e6CheckerCell)+(y/e6CheckerCell))%2 == 0 {
+				col = color.NRGBA{R: uint8(x * 4), G: uint8(y * 4), B: 160, A: 255}
+			}
+
+			img.SetNRGBA(x, y, col)
+		}
+	}
+
+	return img
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"math"
+	"time"
+
+	"github.com/go-glx/glx"
+	"github.com/go-glx/vgl"
+)
+
+type animCameraScroll struct{}
+type animCameraZoom struct{}
+type animCameraRotation struct{}
+
+func e7Camera(rnd *vgl.Render) {
+	w, h := rnd.SurfaceSize()
+
+	// world is drawn with moving camera
+	rnd.SetCamera2D(vgl.Camera2D{
+		Position: glx.Vec2{X: anim(animCameraScroll{}, time.Second*10, 0, 400), Y: 0},
+		Offset:   glx.Vec2{X: w / 2, Y: h / 2},
+		Zoom:     anim(animCameraZoom{}, time.Second*5, 0.5, 2),
+		Rotation: glx.Angle(anim(animCameraRotation{}, time.Second*20, 0, math.Pi*2)),
+	})
+
+	const cellSize = 40
+	for y := -10; y <= 10; y++ {
+		for x := -10; x <= 20; x++ {
+			col := colGrayDark
+			if (x+y)%2 == 0 {
+				col = colGrayLight
+			}
+
+			pos := glx.Vec2{X: float32(x * cellSize), Y: float32(y * cellSize)}
+			rnd.Draw2dRect(&vgl.Params2dRect{
+				Pos: [4]glx.Vec2{
+					pos,
+					pos.Add(glx.Vec2{X: cellSize}),
+					pos.Add(glx.Vec2{X: cellSize, Y: cellSize}),
+					pos.Add(glx.Vec2{Y: cellSize}),
+				},
+				Color:  col,
+				Filled: true,
+			})
+		}
+	}
+
+	rnd.Draw2dCircle(&vgl.Params2dCircle{
+		PosUseCenterRadius: true,
+		PosCenter:          glx.Vec2{X: 0, Y: 0},
+		PosRadius:          cellSize,
+		Color:              colMain,
+	})
+
+	// UI is drawn without camera
+	rnd.SetCamera2D(vgl.Camera2D{})
+
+	rnd.Draw2dRect(&vgl.Params2dRect{
+		Pos:   [4]glx.Vec2{{X: 10, Y: 10}, {X: w - 10, Y: 10}, {X: w - 10, Y: h - 10}, {X: 10, Y: h - 10}},
+		Color: colAccent,
+	})
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"time"
+
+	"github.com/go-glx/glx"
+	"github.com/go-glx/vgl"
+)
+
+type animBlendModesOffset struct{}
+
+func e8BlendModes(rnd *vgl.Render) {
+	modes := []vgl.BlendMode{
+		vgl.BlendModeDefault,
+		vgl.BlendModeAdditive,
+		vgl.BlendModeMultiply,
+		vgl.BlendModeScreen,
+		vgl.BlendModePremultiplied,
+		vgl.BlendModeReplace,
+	}
+
+	w, h := rnd.SurfaceSize()
+	cellW := w / float32(len(modes))
+	radius := cellW / 3
+	offset := anim(animBlendModesOffset{}, time.Second*4, 0, radius)
+
+	for ind, mode := range modes {
+		center := glx.Vec2{X: cellW*float32(ind) + cellW/2, Y: h / 2}
+
+		// background for mode, drawn with default blending
+		rnd.SetBlendMode(vgl.BlendModeDefault)
+		rnd.Draw2dRect(&vgl.Params2dRect{
+			Pos: [4]glx.Vec2{
+				{X: center.X - cellW/2 + 4, Y: h / 4},
+				{X: center.X + cellW/2 - 4, Y: h / 4},
+				{X: center.X + cellW/2 - 4, Y: h * 3 / 4},
+				{X: center.X - cellW/2 + 4, Y: h * 3 / 4},
+			},
+			Color:  colGrayDark,
+			Filled: true,
+		})
+
+		// overlapped circles, drawn with tested mode
+		rnd.SetBlendMode(mode)
+		circleColors := []glx.Color{
+			glx.ColorFromRGBA(255, 0, 0, 180),
+			glx.ColorFromRGBA(0, 255, 0, 180),
+			glx.ColorFromRGBA(0, 0, 255, 180),
+		}
+
+		for cInd, col := range circleColors {
+			rnd.Draw2dCircle(&vgl.Params2dCircle{
+				PosUseCenterRadius: true,
+				PosCenter:          center.Add(glx.Vec2{Y: float32(cInd-1) * offset}),
+				PosRadius:          radius,
+				Color:              col,
+			})
+		}
+	}
+
+	rnd.SetBlendMode(vgl.BlendModeDefault)
+}
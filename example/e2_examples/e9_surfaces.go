@@ -0,0 +1,107 @@
+package main
+
+import (
+	"math"
+	"time"
+
+	"github.com/go-glx/glx"
+	"github.com/go-glx/vgl"
+)
+
+type animSurfaceOrbit struct{}
+
+const e9MinimapSize = 256
+const e9PanelSize = 128
+
+var e9Minimap *vgl.Surface
+var e9Panel *vgl.Surface
+var e9PanelDrawn bool
+
+func e9Surfaces(rnd *vgl.Render) {
+	if e9Minimap == nil {
+		// surfaces should be created once, not every frame
+		e9Minimap = e9MustSurface(rnd, e9MinimapSize)
+		e9Panel = e9MustSurface(rnd, e9PanelSize)
+	}
+
+	w, h := rnd.SurfaceSize()
+	angle := glx.Angle(anim(animSurfaceOrbit{}, time.Second*6, 0, math.Pi*2))
+	center := glx.Vec2{X: w / 2, Y: h / 2}
+	orbit := center.PolarOffset(h/4, angle)
+
+	// world on screen
+	e9World(rnd, center, orbit)
+
+	// same world into minimap, every frame
+	_ = rnd.SetSurface(e9Minimap.ID())
+	rnd.SetCamera2D(vgl.Camera2D{
+		Position: center,
+		Offset:   glx.Vec2{X: e9MinimapSize / 2, Y: e9MinimapSize / 2},
+		Zoom:     0.25,
+	})
+	e9World(rnd, center, orbit)
+
+	// panel is drawn only once, and then reused as cached image
+	if !e9PanelDrawn {
+		_ = rnd.SetSurface(e9Panel.ID())
+		for i := float32(0); i < 8; i++ {
+			rnd.Draw2dCircle(&vgl.Params2dCircle{
+				PosUseCenterRadius: true,
+				PosCenter:          glx.Vec2{X: e9PanelSize / 2, Y: e9PanelSize / 2},
+				PosRadius:          e9PanelSize/2 - i*8,
+				Color:              []glx.Color{colMain, colAccent}[int(i)%2],
+			})
+		}
+
+		e9PanelDrawn = true
+	}
+
+	// draw surfaces on screen, as textures
+	_ = rnd.SetSurface(vgl.SurfaceMain)
+	rnd.SetBlendMode(vgl.BlendModePremultiplied)
+
+	rnd.Draw2dTexture(&vgl.Params2dTexture{
+		Texture: e9Minimap.Texture(),
+		Pos:     e9Quad(glx.Vec2{X: w - e9MinimapSize - 10, Y: 10}, e9MinimapSize),
+	})
+
+	rnd.Draw2dTexture(&vgl.Params2dTexture{
+		Texture: e9Panel.Texture(),
+		Pos:     e9Quad(glx.Vec2{X: 10, Y: h - e9PanelSize - 10}, e9PanelSize),
+	})
+
+	rnd.SetBlendMode(vgl.BlendModeDefault)
+}
+
+func e9World(rnd *vgl.Render, center, orbit glx.Vec2) {
+	rnd.Draw2dRect(&vgl.Params2dRect{
+		Pos:    e9Quad(center.Sub(glx.Vec2{X: 100, Y: 100}), 200),
+		Color:  colGrayDark,
+		Filled: true,
+	})
+
+	rnd.Draw2dCircle(&vgl.Params2dCircle{
+		PosUseCenterRadius: true,
+		PosCenter:          orbit,
+		PosRadius:          40,
+		Color:              colMain,
+	})
+}
+
+func e9Quad(pos glx.Vec2, size float32) [4]glx.Vec2 {
+	return [4]glx.Vec2{
+		pos,
+		pos.Add(glx.Vec2{X: size}),
+		pos.Add(glx.Vec2{X: size, Y: size}),
+		pos.Add(glx.Vec2{Y: size}),
+	}
+}
+
+func e9MustSurface(rnd *vgl.Render, size int) *vgl.Surface {
+	surface, err := rnd.CreateSurface(size, size)
+	if err != nil {
+		panic(err)
+	}
+
+	return surface
+}
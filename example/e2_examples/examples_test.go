@@ -0,0 +1,50 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-glx/vgl"
+	"github.com/go-glx/vgl/vgltest"
+)
+
+var demoNames = map[int]string{
+	demoE0HelloTriangle:   "e0_hello_triangle",
+	demoE1RectDrawOrder:   "e1_rect_draw_order",
+	demoE2Lines:           "e2_lines",
+	demoE3Points:          "e3_points",
+	demoE4Circles:         "e4_circles",
+	demoE5DefaultBlending: "e5_default_blending",
+	demoE6Textures:        "e6_textures",
+	demoE7Camera:          "e7_camera",
+	demoE8BlendModes:      "e8_blend_modes",
+	demoE9Surfaces:        "e9_surfaces",
+}
+
+func TestDemosGolden(t *testing.T) {
+	h := vgltest.New(t, vgltest.WithSize(appWidth, appHeight))
+
+	// freeze all animations at start position
+	frozen := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	animNow = func() time.Time { return frozen }
+	defer func() { animNow = time.Now }()
+
+	for id := 0; id < len(demos); id++ {
+		drawDemo, name := demos[id], demoNames[id]
+
+		if id == demoE1RectDrawOrder {
+			// draw order in e1 is switched every second by stats
+			// listener, golden use default order without switching
+			drawDemo = e1RectDrawOrder(false)
+		}
+
+		t.Run(name, func(t *testing.T) {
+			animDataMap = map[any]*animData{}
+
+			h.AssertGolden(t, name, func(rnd *vgl.Render) {
+				clearScreen(rnd)
+				drawDemo(rnd)
+			})
+		})
+	}
+}
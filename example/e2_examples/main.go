@@ -7,6 +7,7 @@ import (
 	_ "net/http/pprof"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"sort"
 	"strings"
 	"time"
@@ -38,15 +39,23 @@ const (
 	demoE3Points
 	demoE4Circles
 	demoE5DefaultBlending
+	demoE6Textures
+	demoE7Camera
+	demoE8BlendModes
+	demoE9Surfaces
 )
 
 var demos = map[int]func(rnd *vgl.Render){
 	demoE0HelloTriangle:   e0HelloTriangle,
-	demoE1RectDrawOrder:   e1RectDrawOrder,
+	demoE1RectDrawOrder:   e1RectDrawOrder(true),
 	demoE2Lines:           e2Lines,
 	demoE3Points:          e3Points,
 	demoE4Circles:         e4Circles,
 	demoE5DefaultBlending: e5DefaultBlending,
+	demoE6Textures:        e6Textures,
+	demoE7Camera:          e7Camera,
+	demoE8BlendModes:      e8BlendModes,
+	demoE9Surfaces:        e9Surfaces,
 }
 
 func main() {
@@ -54,6 +63,7 @@ func main() {
 	rnd := vgl.NewRender(wm, config.NewConfig(
 		config.WithDebug(true),
 		config.WithMobileFriendly(true),
+		config.WithPipelineCacheFile(filepath.Join(os.TempDir(), "vgl-examples", "pipelines.cache")),
 	))
 	rnd.ListenStats(onFrameEndStats)
 
@@ -120,6 +130,7 @@ func onFrameEndStats(stats metrics.Stats) {
 		// print one time per second
 		combinedStats.DrawCalls += stats.DrawCalls
 		combinedStats.DrawGroups += stats.DrawGroups
+		combinedStats.Culled += stats.Culled
 
 		for segment, duration := range stats.SegmentDuration {
 			if _, exist := combinedStats.SegmentDuration[segment]; !exist {
@@ -146,12 +157,13 @@ func onFrameEndStats(stats metrics.Stats) {
 	// print AVG stats of each frame
 	elapsed := time.Since(startTime)
 	fmt.Printf(""+
-		"+%3.0fs | FPS=%-2d  drawCalls=%.0f \n"+
+		"+%3.0fs | FPS=%-2d  drawCalls=%.0f  culled=%.0f \n"+
 		"  us> | %s\n"+
 		"  mem | total=%s  vert=%s  ind=%s  ubo=%s  ssbo=%s\n",
 		elapsed.Seconds(),
 		stats.FPS,
 		float32(combinedStats.DrawCalls)/avg,
+		float32(combinedStats.Culled)/avg,
 		formatMetricDurations(combinedStats, avg),
 		printMem(stats.Memory.TotalSize, stats.Memory.TotalCapacity),
 		printMem(stats.Memory.VertexBuffers.Size, stats.Memory.VertexBuffers.Capacity),
@@ -12,3 +12,9 @@ require (
 	github.com/go-gl/glfw/v3.3/glfw v0.0.0-20220806181222-55e207c401ad // indirect
 	github.com/vulkan-go/vulkan v0.0.0-20210402152248-956e3850d8f9 // indirect
 )
+
+// examples are built and tested against library sources in this repo
+replace (
+	github.com/go-glx/vgl => ../
+	github.com/go-glx/vgl/arch/glfw => ../arch/glfw
+)
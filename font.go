@@ -0,0 +1,195 @@
+package vgl
+
+import (
+	"fmt"
+	"image"
+	_ "image/png" // bmfont pages is png by default
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// Font is bitmap font (glyph atlas textures + glyph metrics)
+// can be loaded with Render.LoadFont, and used in Draw2dText
+type Font struct {
+	face       string
+	size       float32
+	lineHeight float32
+	base       float32
+	pageW      float32
+	pageH      float32
+	pages      []*Texture
+	glyphs     map[rune]fontGlyph
+	kerning    map[fontKerningPair]float32
+
+	// multi-channel signed distance field font
+	// (drawn with buildIn.msdf shader)
+	msdf          bool
+	distanceRange float32 // distance range in atlas pixels
+}
+
+type (
+	fontGlyph struct {
+		x, y     float32 // region in page (px)
+		atlasW   float32
+		atlasH   float32
+		width    float32 // quad size for native font size (px)
+		height   float32
+		xOffset  float32
+		yOffset  float32
+		xAdvance float32
+		page     int
+	}
+
+	fontKerningPair struct {
+		first  rune
+		second rune
+	}
+)
+
+// Face returns font family name
+func (f *Font) Face() string {
+	return f.face
+}
+
+// Size returns native font size in pixels (glyphs drawn
+// with this size, will be pixel-perfect)
+func (f *Font) Size() float32 {
+	return f.size
+}
+
+// LineHeight returns distance between lines in pixels for native font size
+func (f *Font) LineHeight() float32 {
+	return f.lineHeight
+}
+
+// LoadFont will load AngelCode BMFont (text or binary .fnt) from
+// file system, and upload all font pages as textures to GPU.
+// Page images is resolved relative to .fnt file
+//
+// This is slow function, that wait until all pages is copied to GPU.
+//
+// Font will live until FreeFont is called, or Render closed
+func (r *Render) LoadFont(fsys fs.FS, name string) (*Font, error) {
+	return r.loadFont(fsys, name, TextureFilterLinear)
+}
+
+func (r *Render) loadFont(fsys fs.FS, name string, filter TextureFilter) (*Font, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed load font: %w", err)
+	}
+
+	desc, err := parseBMFont(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed load font `%s`: %w", name, err)
+	}
+
+	font := newFont(desc)
+
+	for _, page := range desc.pages {
+		texture, err := r.loadFontPage(fsys, path.Join(path.Dir(name), page), filter)
+		if err != nil {
+			r.FreeFont(font)
+			return nil, fmt.Errorf("failed load font `%s`: %w", name, err)
+		}
+
+		font.pages = append(font.pages, texture)
+	}
+
+	return font, nil
+}
+
+// IsMSDF is true, when font is multi-channel signed distance field
+// font (loaded with LoadFontMSDF), that stay sharp at any scale
+func (f *Font) IsMSDF() bool {
+	return f.msdf
+}
+
+// LoadFontFile is LoadFont from OS file system
+func (r *Render) LoadFontFile(fileName string) (*Font, error) {
+	return r.LoadFont(os.DirFS(filepath.Dir(fileName)), filepath.Base(fileName))
+}
+
+// FreeFont will release GPU memory of all font pages
+// font cannot be used for drawing after this call
+func (r *Render) FreeFont(f *Font) {
+	if f == nil {
+		return
+	}
+
+	for _, page := range f.pages {
+		r.FreeTexture(page)
+	}
+
+	f.pages = nil
+}
+
+func (r *Render) loadFontPage(fsys fs.FS, name string, filter TextureFilter) (*Texture, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed open page: %w", err)
+	}
+
+	defer file.Close()
+
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed decode page `%s`: %w", name, err)
+	}
+
+	return r.CreateTextureWithFilter(img, filter)
+}
+
+func newFont(desc *bmFont) *Font {
+	font := &Font{
+		face:       desc.face,
+		size:       desc.size,
+		lineHeight: desc.lineHeight,
+		base:       desc.base,
+		pageW:      desc.scaleW,
+		pageH:      desc.scaleH,
+		glyphs:     make(map[rune]fontGlyph, len(desc.chars)),
+		kerning:    make(map[fontKerningPair]float32, len(desc.kernings)),
+	}
+
+	for _, char := range desc.chars {
+		font.glyphs[char.id] = fontGlyph{
+			x:        char.x,
+			y:        char.y,
+			atlasW:   char.width,
+			atlasH:   char.height,
+			width:    char.width,
+			height:   char.height,
+			xOffset:  char.xOffset,
+			yOffset:  char.yOffset,
+			xAdvance: char.xAdvance,
+			page:     char.page,
+		}
+	}
+
+	for _, kerning := range desc.kernings {
+		font.kerning[fontKerningPair{first: kerning.first, second: kerning.second}] = kerning.amount
+	}
+
+	return font
+}
+
+// glyph returns glyph for char, or fallback glyph
+// for chars not existing in font
+func (f *Font) glyph(char rune) (fontGlyph, bool) {
+	if glyph, ok := f.glyphs[char]; ok {
+		return glyph, true
+	}
+
+	if glyph, ok := f.glyphs['\uFFFD']; ok {
+		return glyph, true
+	}
+
+	if glyph, ok := f.glyphs['?']; ok {
+		return glyph, true
+	}
+
+	return fontGlyph{}, false
+}
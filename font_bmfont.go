@@ -0,0 +1,294 @@
+package vgl
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// AngelCode BMFont descriptor (.fnt), see:
+// https://www.angelcode.com/products/bmfont/doc/file_format.html
+
+const (
+	bmFontBinaryVersion = 3
+
+	bmFontBlockInfo     = 1
+	bmFontBlockCommon   = 2
+	bmFontBlockPages    = 3
+	bmFontBlockChars    = 4
+	bmFontBlockKernings = 5
+)
+
+type (
+	bmFont struct {
+		face       string
+		size       float32
+		lineHeight float32
+		base       float32
+		scaleW     float32
+		scaleH     float32
+		pages      []string
+		chars      []bmFontChar
+		kernings   []bmFontKerning
+	}
+
+	bmFontChar struct {
+		id       rune
+		x, y     float32
+		width    float32
+		height   float32
+		xOffset  float32
+		yOffset  float32
+		xAdvance float32
+		page     int
+	}
+
+	bmFontKerning struct {
+		first  rune
+		second rune
+		amount float32
+	}
+)
+
+// parseBMFont decode text or binary BMFont descriptor
+func parseBMFont(data []byte) (*bmFont, error) {
+	var font *bmFont
+	var err error
+
+	if bytes.HasPrefix(data, []byte("BMF")) {
+		font, err = parseBMFontBinary(data)
+	} else {
+		font, err = parseBMFontText(data)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if font.lineHeight <= 0 || font.scaleW <= 0 || font.scaleH <= 0 {
+		return nil, fmt.Errorf("bmfont: missing or invalid common block")
+	}
+
+	if len(font.pages) == 0 {
+		return nil, fmt.Errorf("bmfont: font without pages")
+	}
+
+	for _, char := range font.chars {
+		if char.page < 0 || char.page >= len(font.pages) {
+			return nil, fmt.Errorf("bmfont: char %d reference unknown page %d", char.id, char.page)
+		}
+	}
+
+	if font.size == 0 {
+		font.size = font.lineHeight
+	}
+
+	return font, nil
+}
+
+func parseBMFontText(data []byte) (*bmFont, error) {
+	font := &bmFont{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for line := 1; scanner.Scan(); line++ {
+		tag, attrs, err := parseBMFontLine(scanner.Text())
+		if err != nil {
+			return nil, fmt.Errorf("bmfont: line %d: %w", line, err)
+		}
+
+		num := func(name string) float32 {
+			v, _ := strconv.ParseFloat(attrs[name], 32)
+			return float32(v)
+		}
+
+		switch tag {
+		case "info":
+			font.face = attrs["face"]
+			font.size = num("size")
+			if font.size < 0 {
+				font.size = -font.size
+			}
+		case "common":
+			font.lineHeight = num("lineHeight")
+			font.base = num("base")
+			font.scaleW = num("scaleW")
+			font.scaleH = num("scaleH")
+		case "page":
+			id := int(num("id"))
+			if id < 0 || id > 255 {
+				return nil, fmt.Errorf("bmfont: line %d: invalid page id %d", line, id)
+			}
+			for len(font.pages) <= id {
+				font.pages = append(font.pages, "")
+			}
+
+			font.pages[id] = attrs["file"]
+		case "char":
+			font.chars = append(font.chars, bmFontChar{
+				id:       rune(num("id")),
+				x:        num("x"),
+				y:        num("y"),
+				width:    num("width"),
+				height:   num("height"),
+				xOffset:  num("xoffset"),
+				yOffset:  num("yoffset"),
+				xAdvance: num("xadvance"),
+				page:     int(num("page")),
+			})
+		case "kerning":
+			font.kernings = append(font.kernings, bmFontKerning{
+				first:  rune(num("first")),
+				second: rune(num("second")),
+				amount: num("amount"),
+			})
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("bmfont: %w", err)
+	}
+
+	return font, nil
+}
+
+// parseBMFontLine split line `tag key=value key="quoted value"` into tag and attributes
+func parseBMFontLine(line string) (string, map[string]string, error) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return "", nil, nil
+	}
+
+	tag := line
+	rest := ""
+	if ind := strings.IndexAny(line, " \t"); ind >= 0 {
+		tag, rest = line[:ind], line[ind+1:]
+	}
+
+	attrs := make(map[string]string)
+	for {
+		rest = strings.TrimLeft(rest, " \t")
+		if rest == "" {
+			return tag, attrs, nil
+		}
+
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 {
+			return "", nil, fmt.Errorf("invalid attribute `%s`", rest)
+		}
+
+		key := rest[:eq]
+		rest = rest[eq+1:]
+
+		value := ""
+		if strings.HasPrefix(rest, "\"") {
+			end := strings.IndexByte(rest[1:], '"')
+			if end < 0 {
+				return "", nil, fmt.Errorf("unclosed quote in attribute `%s`", key)
+			}
+
+			value, rest = rest[1:end+1], rest[end+2:]
+		} else {
+			end := strings.IndexAny(rest, " \t")
+			if end < 0 {
+				end = len(rest)
+			}
+
+			value, rest = rest[:end], rest[end:]
+		}
+
+		attrs[key] = value
+	}
+}
+
+func parseBMFontBinary(data []byte) (*bmFont, error) {
+	if len(data) < 4 || data[3] != bmFontBinaryVersion {
+		return nil, fmt.Errorf("bmfont: unsupported binary version (expected %d)", bmFontBinaryVersion)
+	}
+
+	font := &bmFont{}
+	le := binary.LittleEndian
+
+	for offset := 4; offset < len(data); {
+		if offset+5 > len(data) {
+			return nil, fmt.Errorf("bmfont: truncated block header at %d", offset)
+		}
+
+		blockType := data[offset]
+		blockSize := int(le.Uint32(data[offset+1:]))
+		offset += 5
+
+		if blockSize < 0 || offset+blockSize > len(data) {
+			return nil, fmt.Errorf("bmfont: truncated block %d at %d", blockType, offset)
+		}
+
+		block := data[offset : offset+blockSize]
+		offset += blockSize
+
+		switch blockType {
+		case bmFontBlockInfo:
+			if len(block) < 14 {
+				return nil, fmt.Errorf("bmfont: invalid info block")
+			}
+
+			size := int16(le.Uint16(block[0:]))
+			if size < 0 {
+				size = -size
+			}
+
+			font.size = float32(size)
+			font.face = string(bytes.TrimRight(block[14:], "\x00"))
+		case bmFontBlockCommon:
+			if len(block) < 10 {
+				return nil, fmt.Errorf("bmfont: invalid common block")
+			}
+
+			font.lineHeight = float32(le.Uint16(block[0:]))
+			font.base = float32(le.Uint16(block[2:]))
+			font.scaleW = float32(le.Uint16(block[4:]))
+			font.scaleH = float32(le.Uint16(block[6:]))
+		case bmFontBlockPages:
+			for _, name := range bytes.Split(bytes.TrimRight(block, "\x00"), []byte{0}) {
+				font.pages = append(font.pages, string(name))
+			}
+		case bmFontBlockChars:
+			const charSize = 20
+			if len(block)%charSize != 0 {
+				return nil, fmt.Errorf("bmfont: invalid chars block")
+			}
+
+			for i := 0; i < len(block); i += charSize {
+				c := block[i : i+charSize]
+				font.chars = append(font.chars, bmFontChar{
+					id:       rune(le.Uint32(c[0:])),
+					x:        float32(le.Uint16(c[4:])),
+					y:        float32(le.Uint16(c[6:])),
+					width:    float32(le.Uint16(c[8:])),
+					height:   float32(le.Uint16(c[10:])),
+					xOffset:  float32(int16(le.Uint16(c[12:]))),
+					yOffset:  float32(int16(le.Uint16(c[14:]))),
+					xAdvance: float32(int16(le.Uint16(c[16:]))),
+					page:     int(c[18]),
+				})
+			}
+		case bmFontBlockKernings:
+			const kerningSize = 10
+			if len(block)%kerningSize != 0 {
+				return nil, fmt.Errorf("bmfont: invalid kernings block")
+			}
+
+			for i := 0; i < len(block); i += kerningSize {
+				k := block[i : i+kerningSize]
+				font.kernings = append(font.kernings, bmFontKerning{
+					first:  rune(le.Uint32(k[0:])),
+					second: rune(le.Uint32(k[4:])),
+					amount: float32(int16(le.Uint16(k[8:]))),
+				})
+			}
+		}
+	}
+
+	return font, nil
+}
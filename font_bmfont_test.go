@@ -0,0 +1,143 @@
+package vgl
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-glx/vgl/internal/fonts"
+)
+
+const testBMFontText = `info face="Test Sans" size=-16 bold=0 italic=0 charset="" unicode=1 stretchH=100 smooth=1 aa=1 padding=0,0,0,0 spacing=1,1
+common lineHeight=20 base=16 scaleW=128 scaleH=64 pages=2 packed=0
+page id=0 file="test_0.png"
+page id=1 file="test 1.png"
+chars count=3
+char id=65   x=1   y=2   width=10  height=12  xoffset=0  yoffset=4  xadvance=11  page=0 chnl=15
+char id=1078 x=20  y=2   width=12  height=12  xoffset=-1 yoffset=4  xadvance=12  page=1 chnl=15
+char id=32   x=0   y=0   width=0   height=0   xoffset=0  yoffset=0  xadvance=5   page=0 chnl=15
+kernings count=1
+kerning first=65 second=1078 amount=-2
+`
+
+func TestParseBMFont_Text(t *testing.T) {
+	font, err := parseBMFont([]byte(testBMFontText))
+	assert.NoError(t, err)
+
+	assertTestBMFont(t, font)
+}
+
+func TestParseBMFont_Binary(t *testing.T) {
+	buf := &bytes.Buffer{}
+	buf.WriteString("BMF")
+	buf.WriteByte(bmFontBinaryVersion)
+
+	block := func(blockType byte, data ...any) {
+		body := &bytes.Buffer{}
+		for _, v := range data {
+			if s, ok := v.(string); ok {
+				body.WriteString(s)
+				body.WriteByte(0)
+				continue
+			}
+
+			_ = binary.Write(body, binary.LittleEndian, v)
+		}
+
+		buf.WriteByte(blockType)
+		_ = binary.Write(buf, binary.LittleEndian, uint32(body.Len()))
+		buf.Write(body.Bytes())
+	}
+
+	block(bmFontBlockInfo, int16(-16), uint8(0), uint8(0), uint16(100), uint8(1), [4]uint8{}, [2]uint8{1, 1}, uint8(0), "Test Sans")
+	block(bmFontBlockCommon, uint16(20), uint16(16), uint16(128), uint16(64), uint16(2), uint8(0), [4]uint8{})
+	block(bmFontBlockPages, "test_0.png", "test 1.png")
+
+	type char struct {
+		ID                   uint32
+		X, Y, Width, Height  uint16
+		XOffset, YOffset, XA int16
+		Page, Channel        uint8
+	}
+
+	block(bmFontBlockChars,
+		char{ID: 65, X: 1, Y: 2, Width: 10, Height: 12, YOffset: 4, XA: 11, Channel: 15},
+		char{ID: 1078, X: 20, Y: 2, Width: 12, Height: 12, XOffset: -1, YOffset: 4, XA: 12, Page: 1, Channel: 15},
+		char{ID: 32, XA: 5, Channel: 15},
+	)
+	block(bmFontBlockKernings, uint32(65), uint32(1078), int16(-2))
+
+	font, err := parseBMFont(buf.Bytes())
+	assert.NoError(t, err)
+
+	assertTestBMFont(t, font)
+}
+
+func TestParseBMFont_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "empty", data: ""},
+		{name: "without common", data: "info face=\"a\" size=10\npage id=0 file=\"a.png\"\n"},
+		{name: "without pages", data: "common lineHeight=10 base=8 scaleW=64 scaleH=64 pages=0\n"},
+		{name: "unknown page", data: "common lineHeight=10 base=8 scaleW=64 scaleH=64 pages=1\npage id=0 file=\"a.png\"\nchar id=65 page=3\n"},
+		{name: "unclosed quote", data: "info face=\"a size=10\n"},
+		{name: "binary old version", data: "BMF\x02"},
+		{name: "binary truncated block", data: "BMF\x03\x01\xff\x00\x00\x00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseBMFont([]byte(tt.data))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseBMFont_Debug(t *testing.T) {
+	data, err := fs.ReadFile(fonts.Debug, fonts.DebugFontFile)
+	assert.NoError(t, err)
+
+	desc, err := parseBMFont(data)
+	assert.NoError(t, err)
+	assert.Len(t, desc.pages, 1)
+	assert.Len(t, desc.chars, 96) // ascii + replacement char
+
+	page, err := fonts.Debug.Open(desc.pages[0])
+	assert.NoError(t, err)
+	defer page.Close()
+
+	cfg, _, err := image.DecodeConfig(page)
+	assert.NoError(t, err)
+	assert.Equal(t, float32(cfg.Width), desc.scaleW)
+	assert.Equal(t, float32(cfg.Height), desc.scaleH)
+
+	// monospace
+	font := newFont(desc)
+	layout := layoutText(font, "Hello\n\u00e9 world", textLayoutParams{size: desc.size * 2})
+	assert.Equal(t, float32(7*7*2), layout.width) // "é world" with fallback glyph
+	assert.Equal(t, float32(13*2*2), layout.height)
+}
+
+func assertTestBMFont(t *testing.T, font *bmFont) {
+	t.Helper()
+
+	assert.Equal(t, "Test Sans", font.face)
+	assert.Equal(t, float32(16), font.size)
+	assert.Equal(t, float32(20), font.lineHeight)
+	assert.Equal(t, float32(16), font.base)
+	assert.Equal(t, float32(128), font.scaleW)
+	assert.Equal(t, float32(64), font.scaleH)
+	assert.Equal(t, []string{"test_0.png", "test 1.png"}, font.pages)
+	assert.Equal(t, []bmFontChar{
+		{id: 'A', x: 1, y: 2, width: 10, height: 12, xOffset: 0, yOffset: 4, xAdvance: 11, page: 0},
+		{id: 'ж', x: 20, y: 2, width: 12, height: 12, xOffset: -1, yOffset: 4, xAdvance: 12, page: 1},
+		{id: ' ', xAdvance: 5},
+	}, font.chars)
+	assert.Equal(t, []bmFontKerning{{first: 'A', second: 'ж', amount: -2}}, font.kernings)
+}
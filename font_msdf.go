@@ -0,0 +1,164 @@
+package vgl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+)
+
+// msdf-atlas-gen JSON layout, see:
+// https://github.com/Chlumsky/msdf-atlas-gen#json
+
+const (
+	msdfAtlasTypeMSDF  = "msdf"
+	msdfAtlasTypeMTSDF = "mtsdf"
+	msdfYOriginTop     = "top"
+)
+
+type (
+	msdfAtlas struct {
+		Atlas struct {
+			Type          string  `json:"type"`
+			DistanceRange float32 `json:"distanceRange"`
+			Size          float32 `json:"size"`
+			Width         float32 `json:"width"`
+			Height        float32 `json:"height"`
+			YOrigin       string  `json:"yOrigin"`
+		} `json:"atlas"`
+		Metrics struct {
+			LineHeight float32 `json:"lineHeight"`
+			Ascender   float32 `json:"ascender"`
+		} `json:"metrics"`
+		Glyphs  []msdfGlyph   `json:"glyphs"`
+		Kerning []msdfKerning `json:"kerning"`
+	}
+
+	msdfGlyph struct {
+		Unicode     rune        `json:"unicode"`
+		Advance     float32     `json:"advance"`
+		PlaneBounds *msdfBounds `json:"planeBounds"`
+		AtlasBounds *msdfBounds `json:"atlasBounds"`
+	}
+
+	msdfBounds struct {
+		Left   float32 `json:"left"`
+		Bottom float32 `json:"bottom"`
+		Right  float32 `json:"right"`
+		Top    float32 `json:"top"`
+	}
+
+	msdfKerning struct {
+		Unicode1 rune    `json:"unicode1"`
+		Unicode2 rune    `json:"unicode2"`
+		Advance  float32 `json:"advance"`
+	}
+)
+
+// LoadFontMSDF will load multi-channel signed distance field font, generated
+// by msdf-atlas-gen (JSON layout + atlas image), and upload atlas to GPU.
+// MSDF glyphs stay sharp at any scale/zoom/rotation, and support outline and
+// shadow in Draw2dText
+//
+// Atlas should be generated with "-type msdf" (or mtsdf) and single page
+//
+// This is slow function, that wait until atlas is copied to GPU.
+//
+// Font will live until FreeFont is called, or Render closed
+func (r *Render) LoadFontMSDF(fsys fs.FS, layoutName string, imageName string) (*Font, error) {
+	data, err := fs.ReadFile(fsys, layoutName)
+	if err != nil {
+		return nil, fmt.Errorf("failed load msdf font: %w", err)
+	}
+
+	font, err := parseMSDFFont(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed load msdf font `%s`: %w", layoutName, err)
+	}
+
+	// distance field is interpolated between texels
+	texture, err := r.loadFontPage(fsys, imageName, TextureFilterLinear)
+	if err != nil {
+		return nil, fmt.Errorf("failed load msdf font `%s`: %w", layoutName, err)
+	}
+
+	font.pages = []*Texture{texture}
+	return font, nil
+}
+
+// parseMSDFFont convert msdf-atlas-gen layout (em units) into
+// font metrics in atlas pixels (native font size is atlas em size)
+func parseMSDFFont(data []byte) (*Font, error) {
+	layout := msdfAtlas{}
+	if err := json.Unmarshal(data, &layout); err != nil {
+		return nil, fmt.Errorf("msdf: invalid json: %w", err)
+	}
+
+	atlas := layout.Atlas
+	if atlas.Type != msdfAtlasTypeMSDF && atlas.Type != msdfAtlasTypeMTSDF {
+		return nil, fmt.Errorf("msdf: unsupported atlas type `%s` (expected %s or %s)", atlas.Type, msdfAtlasTypeMSDF, msdfAtlasTypeMTSDF)
+	}
+
+	if atlas.Size <= 0 || atlas.Width <= 0 || atlas.Height <= 0 || atlas.DistanceRange <= 0 {
+		return nil, fmt.Errorf("msdf: invalid atlas size or distance range")
+	}
+
+	// convert all vertical metrics to y-down (screen) space,
+	// where negative values is above baseline
+	topOrigin := atlas.YOrigin == msdfYOriginTop
+	flip := func(v float32) float32 {
+		if topOrigin {
+			return v
+		}
+
+		return -v
+	}
+
+	size := atlas.Size
+	ascender := flip(layout.Metrics.Ascender)
+
+	font := &Font{
+		size:          size,
+		lineHeight:    layout.Metrics.LineHeight * size,
+		base:          -ascender * size,
+		pageW:         atlas.Width,
+		pageH:         atlas.Height,
+		glyphs:        make(map[rune]fontGlyph, len(layout.Glyphs)),
+		kerning:       make(map[fontKerningPair]float32, len(layout.Kerning)),
+		msdf:          true,
+		distanceRange: atlas.DistanceRange,
+	}
+
+	for _, g := range layout.Glyphs {
+		glyph := fontGlyph{xAdvance: g.Advance * size}
+
+		if g.PlaneBounds != nil && g.AtlasBounds != nil {
+			top, bottom := flip(g.PlaneBounds.Top), flip(g.PlaneBounds.Bottom)
+			if top > bottom {
+				top, bottom = bottom, top
+			}
+
+			glyph.xOffset = g.PlaneBounds.Left * size
+			glyph.yOffset = (top - ascender) * size
+			glyph.width = (g.PlaneBounds.Right - g.PlaneBounds.Left) * size
+			glyph.height = (bottom - top) * size
+
+			glyph.x = g.AtlasBounds.Left
+			glyph.atlasW = g.AtlasBounds.Right - g.AtlasBounds.Left
+			if topOrigin {
+				glyph.y = g.AtlasBounds.Top
+				glyph.atlasH = g.AtlasBounds.Bottom - g.AtlasBounds.Top
+			} else {
+				glyph.y = atlas.Height - g.AtlasBounds.Top
+				glyph.atlasH = g.AtlasBounds.Top - g.AtlasBounds.Bottom
+			}
+		}
+
+		font.glyphs[g.Unicode] = glyph
+	}
+
+	for _, k := range layout.Kerning {
+		font.kerning[fontKerningPair{first: k.Unicode1, second: k.Unicode2}] = k.Advance * size
+	}
+
+	return font, nil
+}
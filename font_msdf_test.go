@@ -0,0 +1,89 @@
+package vgl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testMSDFLayout = `{
+  "atlas": {"type": "msdf", "distanceRange": 4, "size": 32, "width": 128, "height": 64, "yOrigin": "%s"},
+  "metrics": {"emSize": 1, "lineHeight": 1.25, "ascender": %s, "descender": -0.25},
+  "glyphs": [
+    {"unicode": 65, "advance": 0.5,
+     "planeBounds": {"left": 0, "bottom": %s, "right": 0.5, "top": %s},
+     "atlasBounds": {"left": 10, "bottom": %s, "right": 26, "top": %s}},
+    {"unicode": 32, "advance": 0.25}
+  ],
+  "kerning": [{"unicode1": 65, "unicode2": 65, "advance": -0.0625}]
+}`
+
+func TestParseMSDFFont(t *testing.T) {
+	tests := []struct {
+		name   string
+		layout string
+	}{
+		{
+			name: "y origin bottom",
+			// glyph from baseline to ascender, atlas region y=[20..44] from bottom
+			layout: fmt.Sprintf(testMSDFLayout, "bottom", "0.75", "0", "0.75", "20", "44"),
+		},
+		{
+			name:   "y origin top",
+			layout: fmt.Sprintf(testMSDFLayout, "top", "-0.75", "0", "-0.75", "44", "20"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			font, err := parseMSDFFont([]byte(tt.layout))
+			assert.NoError(t, err)
+
+			assert.True(t, font.IsMSDF())
+			assert.Equal(t, float32(4), font.distanceRange)
+			assert.Equal(t, float32(32), font.Size())
+			assert.Equal(t, float32(40), font.LineHeight())
+			assert.Equal(t, float32(24), font.base)
+
+			a := font.glyphs['A']
+			assert.InDelta(t, 0, a.yOffset, 0.0001)
+			assert.InDelta(t, 16, a.width, 0.0001)
+			assert.InDelta(t, 24, a.height, 0.0001)
+			assert.InDelta(t, 16, a.xAdvance, 0.0001)
+			assert.InDelta(t, 10, a.x, 0.0001)
+			assert.InDelta(t, 20, a.y, 0.0001)
+			assert.InDelta(t, 16, a.atlasW, 0.0001)
+			assert.InDelta(t, 24, a.atlasH, 0.0001)
+
+			space := font.glyphs[' ']
+			assert.InDelta(t, 8, space.xAdvance, 0.0001)
+			assert.Zero(t, space.width)
+
+			assert.InDelta(t, -2, font.kerning[fontKerningPair{first: 'A', second: 'A'}], 0.0001)
+
+			layout := layoutText(font, "AA A", textLayoutParams{size: 64})
+			assert.InDelta(t, (16+16-2+8+16)*2, layout.width, 0.0001)
+			assert.Len(t, layout.quads, 3)
+		})
+	}
+}
+
+func TestParseMSDFFont_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "invalid json", data: "{"},
+		{name: "hard mask atlas", data: `{"atlas": {"type": "hardmask", "distanceRange": 4, "size": 32, "width": 64, "height": 64}}`},
+		{name: "without distance range", data: `{"atlas": {"type": "msdf", "size": 32, "width": 64, "height": 64}}`},
+		{name: "without size", data: `{"atlas": {"type": "mtsdf", "distanceRange": 4, "width": 64, "height": 64}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseMSDFFont([]byte(tt.data))
+			assert.Error(t, err)
+		})
+	}
+}
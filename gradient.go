@@ -0,0 +1,144 @@
+package vgl
+
+import (
+	"math"
+	"sort"
+
+	"github.com/go-glx/glx"
+)
+
+const (
+	GradientLinear GradientType = iota // colors change along line with Angle
+	GradientRadial                     // colors change from Center to Radius
+	GradientConic                      // colors change around Center, starting from Angle
+)
+
+// gradientMaxStops is max count of color stops (see gradient.glsl)
+const gradientMaxStops = 8
+
+type (
+	// GradientType define how gradient colors is placed in shape
+	GradientType uint8
+
+	// Gradient is multi-stop color gradient, that evaluated for every pixel
+	// of filled shape. All positions is relative to shape bounding box
+	Gradient struct {
+		Type   GradientType   // default=GradientLinear
+		Stops  []GradientStop // color stops, max=8 (other stops will be ignored)
+		Angle  glx.Angle      // linear: direction (radians), 0=left to right; conic: start angle
+		Center glx.Vec2       // radial/conic: offset in pixels from shape bounding box center
+		Radius float32        // radial: default=distance from center to farthest bounding box corner
+	}
+
+	// GradientStop is single color of Gradient
+	GradientStop struct {
+		Offset float32   // value [0 .. 1]. Position of color in gradient
+		Color  glx.Color // color in this position
+	}
+
+	// shaderGradient is Gradient prepared for GPU (see gradient.glsl)
+	shaderGradient struct {
+		params   glx.Vec4
+		geometry glx.Vec4
+		offsets  [gradientMaxStops]float32
+		colors   [gradientMaxStops]glx.Vec4
+	}
+)
+
+// shader gradient types
+const (
+	shaderGradientNone float32 = iota
+	shaderGradientLinear
+	shaderGradientRadial
+	shaderGradientConic
+)
+
+// resolve convert gradient into shader gradient for shape
+// with bounding box [min .. max]. Gradient without stops
+// is resolved into "none" gradient
+func (g *Gradient) resolve(min, max glx.Vec2) shaderGradient {
+	result := shaderGradient{}
+	if g == nil || len(g.Stops) == 0 {
+		return result
+	}
+
+	stops := make([]GradientStop, len(g.Stops))
+	copy(stops, g.Stops)
+	sort.SliceStable(stops, func(i, j int) bool {
+		return stops[i].Offset < stops[j].Offset
+	})
+
+	if len(stops) > gradientMaxStops {
+		stops = stops[:gradientMaxStops]
+	}
+
+	for i, stop := range stops {
+		result.offsets[i] = glx.Clamp(stop.Offset, 0, 1)
+		result.colors[i] = stop.Color.VecRGBA()
+	}
+
+	size := max.Sub(min)
+	center := min.Add(size.Scale(0.5)).Add(g.Center)
+	sin, cos := math.Sincos(float64(g.Angle))
+
+	switch g.Type {
+	case GradientRadial:
+		radius := g.Radius
+		if radius <= 0 {
+			dx := math.Max(math.Abs(float64(center.X-min.X)), math.Abs(float64(max.X-center.X)))
+			dy := math.Max(math.Abs(float64(center.Y-min.Y)), math.Abs(float64(max.Y-center.Y)))
+			radius = float32(math.Hypot(dx, dy))
+		}
+
+		result.params.X = shaderGradientRadial
+		result.geometry = glx.Vec4{X: center.X, Y: center.Y, Z: radius}
+	case GradientConic:
+		result.params.X = shaderGradientConic
+		result.geometry = glx.Vec4{X: center.X, Y: center.Y, Z: float32(g.Angle)}
+	default:
+		// gradient line through box center, that cover all box corners
+		half := float32(math.Abs(float64(size.X)*cos)+math.Abs(float64(size.Y)*sin)) / 2
+		dir := glx.Vec2{X: float32(cos), Y: float32(sin)}.Scale(half)
+		start, end := center.Sub(dir), center.Add(dir)
+
+		result.params.X = shaderGradientLinear
+		result.geometry = glx.Vec4{X: start.X, Y: start.Y, Z: end.X, W: end.Y}
+	}
+
+	result.params.Y = float32(len(stops))
+	return result
+}
+
+func (g *shaderGradient) Data() []byte {
+	const size = glx.SizeOfVec4 * (2 + gradientMaxStops/4 + gradientMaxStops)
+	buff := make([]byte, 0, size)
+
+	buff = append(buff, g.params.Data()...)
+	buff = append(buff, g.geometry.Data()...)
+
+	for i := 0; i < gradientMaxStops; i += 4 {
+		offsets := glx.Vec4{X: g.offsets[i], Y: g.offsets[i+1], Z: g.offsets[i+2], W: g.offsets[i+3]}
+		buff = append(buff, offsets.Data()...)
+	}
+
+	for i := range g.colors {
+		buff = append(buff, g.colors[i].Data()...)
+	}
+
+	return buff
+}
+
+// transform gradient geometry, so it match transformed shape
+func (g *shaderGradient) transform(t *transform2d) {
+	center := t.apply(glx.Vec2{X: g.geometry.X, Y: g.geometry.Y})
+
+	switch g.params.X {
+	case shaderGradientLinear:
+		end := t.apply(glx.Vec2{X: g.geometry.Z, Y: g.geometry.W})
+		g.geometry = glx.Vec4{X: center.X, Y: center.Y, Z: end.X, W: end.Y}
+	case shaderGradientRadial:
+		g.geometry = glx.Vec4{X: center.X, Y: center.Y, Z: g.geometry.Z * t.scale()}
+	case shaderGradientConic:
+		g.geometry = glx.Vec4{X: center.X, Y: center.Y, Z: g.geometry.Z + float32(t.rotation())}
+	}
+}
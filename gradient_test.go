@@ -0,0 +1,88 @@
+package vgl
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-glx/glx"
+)
+
+func TestGradient_Resolve(t *testing.T) {
+	stops := []GradientStop{{Offset: 0, Color: glx.ColorWhite}, {Offset: 1, Color: glx.ColorWhite}}
+	min, max := glx.Vec2{X: 0, Y: 0}, glx.Vec2{X: 100, Y: 50}
+
+	tests := []struct {
+		name     string
+		gradient *Gradient
+		typ      float32
+		geometry glx.Vec4
+	}{
+		{name: "nil", gradient: nil, typ: shaderGradientNone},
+		{name: "without stops", gradient: &Gradient{}, typ: shaderGradientNone},
+		{
+			name:     "linear left to right",
+			gradient: &Gradient{Stops: stops},
+			typ:      shaderGradientLinear,
+			geometry: glx.Vec4{X: 0, Y: 25, Z: 100, W: 25},
+		},
+		{
+			name:     "linear top to bottom",
+			gradient: &Gradient{Stops: stops, Angle: math.Pi / 2},
+			typ:      shaderGradientLinear,
+			geometry: glx.Vec4{X: 50, Y: 0, Z: 50, W: 50},
+		},
+		{
+			name:     "linear diagonal cover corners",
+			gradient: &Gradient{Stops: stops, Angle: math.Pi / 4},
+			typ:      shaderGradientLinear,
+			geometry: glx.Vec4{X: 50 - 37.5, Y: 25 - 37.5, Z: 50 + 37.5, W: 25 + 37.5},
+		},
+		{
+			name:     "radial farthest corner",
+			gradient: &Gradient{Type: GradientRadial, Stops: stops, Center: glx.Vec2{X: 10}},
+			typ:      shaderGradientRadial,
+			geometry: glx.Vec4{X: 60, Y: 25, Z: float32(math.Hypot(60, 25))},
+		},
+		{
+			name:     "radial with radius",
+			gradient: &Gradient{Type: GradientRadial, Stops: stops, Radius: 10},
+			typ:      shaderGradientRadial,
+			geometry: glx.Vec4{X: 50, Y: 25, Z: 10},
+		},
+		{
+			name:     "conic",
+			gradient: &Gradient{Type: GradientConic, Stops: stops, Angle: 1},
+			typ:      shaderGradientConic,
+			geometry: glx.Vec4{X: 50, Y: 25, Z: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := tt.gradient.resolve(min, max)
+
+			assert.Equal(t, tt.typ, g.params.X)
+			assert.InDeltaSlice(t,
+				[]float32{tt.geometry.X, tt.geometry.Y, tt.geometry.Z, tt.geometry.W},
+				[]float32{g.geometry.X, g.geometry.Y, g.geometry.Z, g.geometry.W},
+				0.001,
+			)
+		})
+	}
+}
+
+func TestGradient_ResolveStops(t *testing.T) {
+	gradient := &Gradient{}
+	for i := 10; i > 0; i-- {
+		gradient.Stops = append(gradient.Stops, GradientStop{Offset: float32(i) / 8})
+	}
+
+	g := gradient.resolve(glx.Vec2{}, glx.Vec2{X: 1, Y: 1})
+
+	// sorted, clamped and truncated to max stops
+	assert.Equal(t, float32(gradientMaxStops), g.params.Y)
+	assert.Equal(t, [gradientMaxStops]float32{0.125, 0.25, 0.375, 0.5, 0.625, 0.75, 0.875, 1}, g.offsets)
+	assert.Len(t, g.Data(), glx.SizeOfVec4*12)
+}
@@ -0,0 +1,14 @@
+package fonts
+
+import (
+	"embed"
+)
+
+// Debug is monospace 7x13 bitmap font (ASCII + U+FFFD) in BMFont
+// format, derived from public domain X11 misc-fixed font files
+//
+//go:embed debug.fnt debug_0.png
+var Debug embed.FS
+
+// DebugFontFile is name of BMFont descriptor in Debug file system
+const DebugFontFile = "debug.fnt"
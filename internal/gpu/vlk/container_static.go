@@ -5,13 +5,17 @@ import (
 	"github.com/vulkan-go/vulkan"
 
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/alloc"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/command"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/dscptr"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/instance"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/logical"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/physical"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/pipeline"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/renderpass"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/shader"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/surface"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/target"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/texture"
 )
 
 func (c *Container) instance() *instance.Instance {
@@ -43,6 +47,13 @@ func (c *Container) instance() *instance.Instance {
 
 func (c *Container) surface() *surface.Surface {
 	return static(c, func() *surface.Surface {
+		if c.cfg.IsHeadless() {
+			return surface.NewHeadless(
+				c.logger,
+				c.instance(),
+			)
+		}
+
 		return surface.NewSurface(
 			c.logger,
 			c.instance(),
@@ -73,8 +84,36 @@ func (c *Container) logicalDevice() *logical.Device {
 func (c *Container) pipelineCache() *pipeline.Cache {
 	return static(c, func() *pipeline.Cache {
 		return pipeline.NewCache(
+			c.logger,
+			c.physicalDevice(),
+			c.logicalDevice(),
+			c.cfg.PipelineCacheFile(),
+		)
+	})
+}
+
+func (c *Container) renderPassMain() *renderpass.Pass {
+	// main pass depends only on surface format, that not changed
+	// on window resize, so pass (and pipelines) live until close
+	return static(c, func() *renderpass.Pass {
+		return renderpass.NewMain(
+			c.logger,
+			c.physicalDevice(),
+			c.logicalDevice(),
+		)
+	})
+}
+
+func (c *Container) pipelineFactory() *pipeline.Factory {
+	// pipelines use dynamic viewport/scissor, so they
+	// not need rebuild with swapChain
+	return static(c, func() *pipeline.Factory {
+		return pipeline.NewFactory(
 			c.logger,
 			c.logicalDevice(),
+			c.renderPassMain(),
+			c.descriptorsManager(),
+			c.pipelineCache(),
 		)
 	})
 }
@@ -88,6 +127,19 @@ func (c *Container) shaderManager() *shader.Manager {
 	})
 }
 
+func (c *Container) commandPool() *command.Pool {
+	// pool is static, because memory allocator (static)
+	// use it for all uploading operations, and should
+	// not lose it on swapChain rebuild
+	return static(c, func() *command.Pool {
+		return command.NewPool(
+			c.logger,
+			c.physicalDevice(),
+			c.logicalDevice(),
+		)
+	})
+}
+
 func (c *Container) memoryAllocator() *alloc.Allocator {
 	return static(c, func() *alloc.Allocator {
 		return alloc.NewAllocator(
@@ -136,3 +188,37 @@ func (c *Container) descriptorsManager() *dscptr.Manager {
 		)
 	})
 }
+
+func (c *Container) textureManager() *texture.Manager {
+	return static(c, func() *texture.Manager {
+		return texture.NewManager(
+			c.logger,
+			c.logicalDevice(),
+			c.allocHeap(),
+			c.descriptorsManager(),
+		)
+	})
+}
+
+func (c *Container) renderPassOffscreen() *renderpass.Pass {
+	// off-screen pass not depend on swapChain format,
+	// so it not need rebuild with window
+	return static(c, func() *renderpass.Pass {
+		return renderpass.NewOffscreen(
+			c.logger,
+			c.logicalDevice(),
+			texture.Format,
+		)
+	})
+}
+
+func (c *Container) renderTargets() *target.Manager {
+	return static(c, func() *target.Manager {
+		return target.NewManager(
+			c.logger,
+			c.logicalDevice(),
+			c.textureManager(),
+			c.renderPassOffscreen(),
+		)
+	})
+}
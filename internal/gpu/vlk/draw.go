@@ -17,17 +17,24 @@ func (vlk *VLK) setSurface(ID surfaceID) {
 
 	if len(vlk.drawContext.surfaces) == 0 {
 		// create new surface, if not already exist
-		vlk.drawContext.surfaces = append(vlk.drawContext.surfaces, newDrawSurface(ID))
+		vlk.drawContext.surfaces = append(vlk.drawContext.surfaces, newDrawSurface(ID, vlk.surfacesView[ID]))
 		return
 	}
 
 	current := vlk.drawContext.surfaces[len(vlk.drawContext.surfaces)-1]
-	if current.surfaceID == ID {
+	if current.surfaceID == ID && current.view == vlk.surfacesView[ID] {
 		// switched to same surface
 		return
 	}
 
-	// switched to new surface, break baking
+	if len(current.groups) == 0 {
+		// surface (or view) changed, but nothing is drawn yet
+		current.surfaceID = ID
+		current.view = vlk.surfacesView[ID]
+		return
+	}
+
+	// switched to new surface (or surface view changed), break baking
 	if len(vlk.drawContext.surfaces) >= maxSurfaces {
 		vlk.cont.logger.Error(fmt.Sprintf("failed switch surface, max surfaces switch count %d is reached",
 			maxSurfaces,
@@ -35,7 +42,7 @@ func (vlk *VLK) setSurface(ID surfaceID) {
 		return
 	}
 
-	vlk.drawContext.surfaces = append(vlk.drawContext.surfaces, newDrawSurface(ID))
+	vlk.drawContext.surfaces = append(vlk.drawContext.surfaces, newDrawSurface(ID, vlk.surfacesView[ID]))
 }
 
 func (vlk *VLK) drawQueue(shader *shader.Shader, opts DrawOptions, instance shader.InstanceData) {
@@ -56,9 +63,9 @@ func (vlk *VLK) drawQueue(shader *shader.Shader, opts DrawOptions, instance shad
 func (vlk *VLK) autoBake(shader *shader.Shader, opts DrawOptions) bool {
 	brakeBaking := false
 
-	// create default surface, if not set
+	// create current surface, if not set
 	if len(vlk.drawContext.surfaces) == 0 {
-		vlk.setSurface(surfaceIdMainWindow)
+		vlk.setSurface(vlk.surfaceInd)
 	}
 
 	// get current surface
@@ -66,7 +73,7 @@ func (vlk *VLK) autoBake(shader *shader.Shader, opts DrawOptions) bool {
 
 	// create new draw group, if not empty
 	if len(currSurf.groups) == 0 {
-		currSurf.groups = append(currSurf.groups, newDrawGroup(shader, opts.PolygonMode))
+		currSurf.groups = append(currSurf.groups, newDrawGroup(shader, opts))
 	}
 
 	currGroup := currSurf.groups[len(currSurf.groups)-1]
@@ -76,13 +83,11 @@ func (vlk *VLK) autoBake(shader *shader.Shader, opts DrawOptions) bool {
 		brakeBaking = true
 	}
 
-	// brake: polygon mode changed
-	if currGroup.polygonMode != opts.PolygonMode {
+	// brake: any other group param changed
+	if !currGroup.sameOptions(opts) {
 		brakeBaking = true
 	}
 
-	// brake: blend mode changed (todo)
-
 	if !brakeBaking {
 		return true
 	}
@@ -95,10 +100,21 @@ func (vlk *VLK) autoBake(shader *shader.Shader, opts DrawOptions) bool {
 		return false
 	}
 
-	currSurf.groups = append(currSurf.groups, newDrawGroup(shader, opts.PolygonMode))
+	currSurf.groups = append(currSurf.groups, newDrawGroup(shader, opts))
 	return true
 }
 
+// sameOptions check that instance with opts can be drawn in this
+// group (with same pipeline, scissor and texture set)
+func (g *drawGroup) sameOptions(opts DrawOptions) bool {
+	return g.polygonMode == opts.PolygonMode &&
+		g.texture == opts.Texture &&
+		g.blendMode == opts.BlendMode &&
+		g.hasClip == opts.HasClip &&
+		g.clip == opts.Clip &&
+		g.maskMode == opts.MaskMode
+}
+
 // draw is actual drawing function, should be called every frame
 // just before frame end.
 func (vlk *VLK) draw() {
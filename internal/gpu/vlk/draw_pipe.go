@@ -1,7 +1,7 @@
 package vlk
 
 import (
-	"strconv"
+	"fmt"
 	"time"
 
 	"github.com/vulkan-go/vulkan"
@@ -10,15 +10,17 @@ import (
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/def"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/dscptr"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/pipeline"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/target"
 	"github.com/go-glx/vgl/shared/metrics"
 )
 
 type (
-	drawCtxFn       = func(ctx *drawContext)
-	drawSurfaceFn   = func(ctx *drawContext, surf *drawSurface)
-	drawGroupFn     = func(ctx *drawContext, g *drawGroup)
-	drawGroupExecFn = func(cb vulkan.CommandBuffer, ctx *drawContext, surf *drawSurface, g *drawGroup)
-	drawCallExecFn  = func(cb vulkan.CommandBuffer, ctx *drawContext, surf *drawSurface, g *drawGroup, c *drawCall)
+	drawCtxFn         = func(ctx *drawContext)
+	drawSurfaceFn     = func(ctx *drawContext, surf *drawSurface)
+	drawSurfaceExecFn = func(cb vulkan.CommandBuffer, ctx *drawContext, surf *drawSurface)
+	drawGroupFn       = func(ctx *drawContext, surf *drawSurface, g *drawGroup)
+	drawGroupExecFn   = func(cb vulkan.CommandBuffer, ctx *drawContext, surf *drawSurface, g *drawGroup)
+	drawCallExecFn    = func(cb vulkan.CommandBuffer, ctx *drawContext, surf *drawSurface, g *drawGroup, c *drawCall)
 )
 
 // ~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=
@@ -31,19 +33,25 @@ func (vlk *VLK) initDrawingPipeline() {
 		vlk.plUpdateGlobalRendererVars,
 		vlk.plWhenAvailable(
 			vlk.plClearVertexBuffers,
+			vlk.plClearUniformBuffers,
 			vlk.plOnEverySurface(
+				vlk.plSurfaceFindTarget,
 				vlk.plSurfaceUpdateGlobalUniform,
 				vlk.plSurfaceOnEveryGroup(
 					vlk.plGroupStats,
+					vlk.plGroupFindTexture,
 					vlk.plGroupCreateRenderingPipeline,
 					vlk.plGroupFindIndexBuffer,
 					vlk.plGroupUpdateVertexBuffer,
 				),
 			),
-			vlk.plOnEverySurface(
+			vlk.plOnEverySurfaceExec(
+				vlk.plExecSurfaceSetViewport,
 				vlk.plSurfaceOnEveryGroupExec(
 					vlk.plExecGroupBindPipeline,
+					vlk.plExecGroupSetScissor,
 					vlk.plExecGroupBindIndexBuffer,
+					vlk.plExecGroupBindTexture,
 					vlk.plExecGroupOnEveryCall(
 						vlk.plExecCallUpdateLocalUniforms,
 						vlk.plExecCallBindUniforms,
@@ -99,28 +107,67 @@ func (vlk *VLK) plSurfaceOnEveryGroup(groupFns ...drawGroupFn) drawSurfaceFn {
 			}
 
 			for _, fn := range groupFns {
-				fn(ctx, group)
+				fn(ctx, surf, group)
 			}
 		}
 	}
 }
 
-func (vlk *VLK) plSurfaceOnEveryGroupExec(groupFns ...drawGroupExecFn) drawSurfaceFn {
-	return func(ctx *drawContext, surf *drawSurface) {
-		vlk.cont.frameManager().FrameApplyCommands(vlk.drawFrameCtx, func(cb vulkan.CommandBuffer) {
-			for _, group := range surf.groups {
-				if len(group.instances) == 0 {
+func (vlk *VLK) plOnEverySurfaceExec(surfaceFns ...drawSurfaceExecFn) drawCtxFn {
+	return func(ctx *drawContext) {
+		// off-screen surfaces is rendered first (in queue order), because
+		// vulkan not allow nested render passes, and main surface (or next
+		// off-screen surfaces) can sample them as textures in this frame
+		cleared := make(map[surfaceID]bool)
+
+		for _, surface := range ctx.surfaces {
+			if surface.target == nil || len(surface.groups) == 0 {
+				continue
+			}
+
+			vlk.cont.frameManager().FrameApplyCommands(vlk.drawFrameCtx, func(cb vulkan.CommandBuffer) {
+				// target content is cleared only on first pass in frame
+				// so surface can be switched many times without data loss
+				vlk.cont.renderTargets().Begin(cb, surface.target, !cleared[surface.surfaceID])
+
+				for _, fn := range surfaceFns {
+					fn(cb, ctx, surface)
+				}
+
+				vlk.cont.renderTargets().End(cb)
+			})
+
+			cleared[surface.surfaceID] = true
+		}
+
+		vlk.cont.frameManager().FrameApplyMainCommands(vlk.drawFrameCtx, func(cb vulkan.CommandBuffer) {
+			for _, surface := range ctx.surfaces {
+				if surface.surfaceID != surfaceIdMainWindow {
 					continue
 				}
 
-				for _, fn := range groupFns {
-					fn(cb, ctx, surf, group)
+				for _, fn := range surfaceFns {
+					fn(cb, ctx, surface)
 				}
 			}
 		})
 	}
 }
 
+func (vlk *VLK) plSurfaceOnEveryGroupExec(groupFns ...drawGroupExecFn) drawSurfaceExecFn {
+	return func(cb vulkan.CommandBuffer, ctx *drawContext, surf *drawSurface) {
+		for _, group := range surf.groups {
+			if len(group.instances) == 0 {
+				continue
+			}
+
+			for _, fn := range groupFns {
+				fn(cb, ctx, surf, group)
+			}
+		}
+	}
+}
+
 // ~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=
 // Functions - Global CTX
 // ~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=
@@ -143,6 +190,14 @@ func (vlk *VLK) plClearVertexBuffers(ctx *drawContext) {
 	vlk.stats.SegmentDuration[metrics.SegmentPlClearBuffers] += time.Since(ts)
 }
 
+func (vlk *VLK) plClearUniformBuffers(ctx *drawContext) {
+	ts := time.Now()
+
+	vlk.cont.descriptorsManager().ResetFrame(ctx.currentFrameID)
+
+	vlk.stats.SegmentDuration[metrics.SegmentPlClearBuffers] += time.Since(ts)
+}
+
 func (vlk *VLK) plClearContext(ctx *drawContext) {
 	ctx.available = false
 	ctx.surfaces = make([]*drawSurface, 0, defaultSurfacesCapacity)
@@ -152,20 +207,37 @@ func (vlk *VLK) plClearContext(ctx *drawContext) {
 // Functions - Surfaces
 // ~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=
 
+func (vlk *VLK) plSurfaceFindTarget(_ *drawContext, surf *drawSurface) {
+	if surf.surfaceID == surfaceIdMainWindow {
+		return
+	}
+
+	rt, exist := vlk.cont.renderTargets().Target(target.ID(surf.surfaceID))
+	if !exist {
+		// surface deleted after drawing is queued, so
+		// nothing to draw on it
+		vlk.cont.logger.Error(fmt.Sprintf("surface %d is deleted while used in drawing. Skip rendering..", surf.surfaceID))
+		surf.groups = surf.groups[:0]
+		return
+	}
+
+	surf.target = rt
+}
+
 func (vlk *VLK) plSurfaceUpdateGlobalUniform(ctx *drawContext, surf *drawSurface) {
 	ts := time.Now()
 
 	// write global data to uniform buffers that need for every shader
-	view := glx.Mat4Identity()       // todo
-	projection := glx.Mat4Identity() // todo
+	// all vertexes is in surface pixel space, view will transform it
+	// with surface camera, and projection into vulkan clip space
 	surfaceSize := glx.Vec2{
 		X: vlk.surfacesSize[surf.surfaceID][0],
 		Y: vlk.surfacesSize[surf.surfaceID][1],
 	}
 
 	uboData := make([]byte, 0, glx.SizeOfMat4*2)
-	uboData = append(uboData, view.Data()...)
-	uboData = append(uboData, projection.Data()...)
+	uboData = append(uboData, mat4Data(surf.view)...)
+	uboData = append(uboData, mat4Data(mat4Ortho2d(surfaceSize.X, surfaceSize.Y))...)
 
 	surf.uniform = vlk.cont.descriptorsManager().UpdateSet(
 		ctx.currentFrameID,
@@ -178,50 +250,98 @@ func (vlk *VLK) plSurfaceUpdateGlobalUniform(ctx *drawContext, surf *drawSurface
 	vlk.stats.SegmentDuration[metrics.SegmentPlUpdateGlobalUniform] += time.Since(ts)
 }
 
+func (vlk *VLK) plExecSurfaceSetViewport(cb vulkan.CommandBuffer, _ *drawContext, surf *drawSurface) {
+	// viewport is dynamic pipeline state, so same
+	// pipelines can be used for any surface size
+	viewport := vlk.cont.swapChain().Viewport()
+	if surf.target != nil {
+		viewport = surf.target.Viewport()
+	}
+
+	vulkan.CmdSetViewport(cb, 0, 1, []vulkan.Viewport{viewport})
+	vulkan.CmdSetScissor(cb, 0, 1, []vulkan.Rect2D{vlk.surfaceScissor(surf)})
+}
+
+func (vlk *VLK) surfaceScissor(surf *drawSurface) vulkan.Rect2D {
+	if surf.target != nil {
+		return surf.target.Scissor()
+	}
+
+	return vlk.cont.swapChain().Scissor()
+}
+
 // ~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=
 // Functions - Groups
 // ~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=~=
 
-func (vlk *VLK) plGroupStats(_ *drawContext, _ *drawGroup) {
+func (vlk *VLK) plGroupStats(_ *drawContext, _ *drawSurface, _ *drawGroup) {
 	vlk.stats.DrawGroups++
 }
 
-func (vlk *VLK) plGroupCreateRenderingPipeline(_ *drawContext, g *drawGroup) {
-	cacheKey := g.shader.Meta().ID() + strconv.FormatInt(int64(g.polygonMode), 10)
+func (vlk *VLK) plGroupFindTexture(_ *drawContext, _ *drawSurface, g *drawGroup) {
+	if g.texture == 0 {
+		return
+	}
 
-	// todo: cache is broken on screen resolution change
-	//if pipe, exist := vlk.drawPipelineCache[cacheKey]; exist {
-	//	g.renderPipe = pipe
-	//	return
-	//}
+	tex, exist := vlk.cont.textureManager().Texture(g.texture)
+	if !exist {
+		// texture deleted after drawing is queued, so
+		// nothing to draw in this group
+		vlk.cont.logger.Error(fmt.Sprintf("texture %d is deleted while used in drawing. Skip rendering..", g.texture))
+		g.instances = g.instances[:0]
+		return
+	}
 
+	g.textureSet = tex.DescriptorSet()
+}
+
+func (vlk *VLK) plGroupCreateRenderingPipeline(_ *drawContext, surf *drawSurface, g *drawGroup) {
 	ts := time.Now()
 
-	pipe := vlk.cont.pipelineFactory().NewPipeline(
-		pipeline.WithStages([]vulkan.PipelineShaderStageCreateInfo{
-			g.shader.ModuleVert().Stage(),
-			g.shader.ModuleFrag().Stage(),
-		}),
-		pipeline.WithTopology(
-			g.shader.Meta().Topology(),
-			g.shader.Meta().TopologyRestartEnable(),
-		),
-		pipeline.WithVertexInput(
-			g.shader.Meta().Bindings(),
-			g.shader.Meta().Attributes(),
-		),
-		pipeline.WithRasterization(g.polygonMode),
-		pipeline.WithColorBlend(),
-		pipeline.WithMultisampling(),
-	)
+	key := pipeline.Key{
+		Shader:        g.shader.Meta().ID(),
+		Topology:      g.shader.Meta().Topology(),
+		RestartEnable: g.shader.Meta().TopologyRestartEnable(),
+		PolygonMode:   g.polygonMode,
+		BlendMode:     g.blendMode,
+		MaskMode:      g.maskMode,
+		Samples:       vulkan.SampleCount1Bit,
+		RenderPass:    vlk.cont.renderPassMain().Ref(),
+	}
+
+	if surf.target != nil {
+		// off-screen surface has own render pass
+		key.RenderPass = vlk.cont.renderTargets().RenderPass().Ref()
+	}
+
+	g.renderPipe = vlk.cont.pipelineFactory().CachedPipeline(key, func() []pipeline.Initializer {
+		opts := []pipeline.Initializer{
+			pipeline.WithStages([]vulkan.PipelineShaderStageCreateInfo{
+				g.shader.ModuleVert().Stage(),
+				g.shader.ModuleFrag().Stage(),
+			}),
+			pipeline.WithTopology(key.Topology, key.RestartEnable),
+			pipeline.WithVertexInput(
+				g.shader.Meta().Bindings(),
+				g.shader.Meta().Attributes(),
+			),
+			pipeline.WithRasterization(key.PolygonMode),
+			pipeline.WithColorBlend(key.BlendMode),
+			pipeline.WithMask(key.MaskMode),
+			pipeline.WithMultisampling(key.Samples),
+		}
+
+		if surf.target != nil {
+			opts = append(opts, pipeline.WithRenderPass(vlk.cont.renderTargets().RenderPass()))
+		}
 
-	g.renderPipe = pipe
-	vlk.drawPipelineCache[cacheKey] = pipe
+		return opts
+	})
 
 	vlk.stats.SegmentDuration[metrics.SegmentPlCreatePipeline] += time.Since(ts)
 }
 
-func (vlk *VLK) plGroupFindIndexBuffer(_ *drawContext, g *drawGroup) {
+func (vlk *VLK) plGroupFindIndexBuffer(_ *drawContext, _ *drawSurface, g *drawGroup) {
 	ts := time.Now()
 
 	// find global shader indexes of this shader
@@ -237,7 +357,7 @@ func (vlk *VLK) plGroupFindIndexBuffer(_ *drawContext, g *drawGroup) {
 	vlk.stats.SegmentDuration[metrics.SegmentPlUpdateIndexes] += time.Since(ts)
 }
 
-func (vlk *VLK) plGroupUpdateVertexBuffer(ctx *drawContext, g *drawGroup) {
+func (vlk *VLK) plGroupUpdateVertexBuffer(ctx *drawContext, _ *drawSurface, g *drawGroup) {
 	ts := time.Now()
 	chunks := vlk.cont.allocBuffers().WriteVertexBuffersFromInstances(ctx.currentFrameID, g.instances)
 
@@ -274,6 +394,22 @@ func (vlk *VLK) plExecGroupBindPipeline(cb vulkan.CommandBuffer, _ *drawContext,
 	vlk.stats.SegmentDuration[metrics.SegmentPlBindPipeline] += time.Since(ts)
 }
 
+func (vlk *VLK) plExecGroupSetScissor(cb vulkan.CommandBuffer, _ *drawContext, surf *drawSurface, g *drawGroup) {
+	// scissor is dynamic pipeline state, so clip rect can be
+	// changed between groups without pipeline rebuild
+	vulkan.CmdSetScissor(cb, 0, 1, []vulkan.Rect2D{groupScissor(vlk.surfaceScissor(surf), g)})
+}
+
+// groupScissor returns surface scissor limited by group clip rect. Clip
+// outside of surface gives zero size scissor, so nothing is drawn
+func groupScissor(surfaceScissor vulkan.Rect2D, g *drawGroup) vulkan.Rect2D {
+	if !g.hasClip {
+		return surfaceScissor
+	}
+
+	return intersectRect2D(surfaceScissor, g.clip)
+}
+
 func (vlk *VLK) plExecGroupBindIndexBuffer(cb vulkan.CommandBuffer, _ *drawContext, _ *drawSurface, g *drawGroup) {
 	if !g.indexes.used {
 		return
@@ -286,6 +422,28 @@ func (vlk *VLK) plExecGroupBindIndexBuffer(cb vulkan.CommandBuffer, _ *drawConte
 	vlk.stats.SegmentDuration[metrics.SegmentPlBindIndexes] += time.Since(ts)
 }
 
+func (vlk *VLK) plExecGroupBindTexture(cb vulkan.CommandBuffer, _ *drawContext, _ *drawSurface, g *drawGroup) {
+	if g.texture == 0 {
+		return
+	}
+
+	ts := time.Now()
+
+	// layout = 3, texture sampler
+	vulkan.CmdBindDescriptorSets(
+		cb,
+		vulkan.PipelineBindPointGraphics,
+		g.renderPipe.Layout,
+		dscptr.LayoutIndexTexture,
+		1,
+		[]vulkan.DescriptorSet{g.textureSet},
+		0,
+		nil,
+	)
+
+	vlk.stats.SegmentDuration[metrics.SegmentPlBindTexture] += time.Since(ts)
+}
+
 func (vlk *VLK) plExecGroupOnEveryCall(callFns ...drawCallExecFn) drawGroupExecFn {
 	return func(cb vulkan.CommandBuffer, ctx *drawContext, surf *drawSurface, g *drawGroup) {
 		for _, call := range g.calls {
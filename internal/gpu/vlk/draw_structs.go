@@ -7,6 +7,7 @@ import (
 
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/pipeline"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/shader"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/target"
 )
 
 const maxSurfaces = math.MaxUint8
@@ -26,10 +27,12 @@ type (
 	drawSurface struct {
 		// baking
 		surfaceID surfaceID    // current surface ID
+		view      mat4         // view (camera) matrix for all groups of this surface
 		groups    []*drawGroup // shader groups for drawing
 
 		// dynamic
 		uniform vulkan.DescriptorSet // global UBO set (view, projection)
+		target  *target.Target       // off-screen render target (nil for main window)
 	}
 
 	drawGroup struct {
@@ -37,12 +40,17 @@ type (
 		shader      *shader.Shader        // ref to group shader
 		instances   []shader.InstanceData // raw instances data that should be used for drawing
 		polygonMode vulkan.PolygonMode    // render polygon mode
-		// blendingMode vulkan.BlendOp // todo: blending
+		texture     TextureID             // sampled texture (0 = without texture)
+		blendMode   pipeline.BlendMode    // color blending with surface
+		clip        vulkan.Rect2D         // scissor rect (used only with hasClip)
+		hasClip     bool                  // clipped by clip rect (otherwise by whole surface)
+		maskMode    pipeline.MaskMode     // stencil mask writing/testing
 
 		// dynamic
-		renderPipe pipeline.Info // created vk pipeline object for group params
-		indexes    bufferBinding // index buffer info
-		calls      []*drawCall   // instances transformed to calls
+		renderPipe pipeline.Info        // created vk pipeline object for group params
+		indexes    bufferBinding        // index buffer info
+		textureSet vulkan.DescriptorSet // texture set, when group use texture
+		calls      []*drawCall          // instances transformed to calls
 	}
 
 	drawCall struct {
@@ -64,18 +72,24 @@ func newDrawContext() *drawContext {
 	}
 }
 
-func newDrawSurface(ID surfaceID) *drawSurface {
+func newDrawSurface(ID surfaceID, view mat4) *drawSurface {
 	return &drawSurface{
 		surfaceID: ID,
+		view:      view,
 		groups:    make([]*drawGroup, 0, defaultGroupCapacity),
 	}
 }
 
-func newDrawGroup(sdr *shader.Shader, polygonMode vulkan.PolygonMode) *drawGroup {
+func newDrawGroup(sdr *shader.Shader, opts DrawOptions) *drawGroup {
 	return &drawGroup{
 		shader:      sdr,
 		instances:   make([]shader.InstanceData, 0, defaultInstancesCapacity),
-		polygonMode: polygonMode,
+		polygonMode: opts.PolygonMode,
+		texture:     opts.Texture,
+		blendMode:   opts.BlendMode,
+		clip:        opts.Clip,
+		hasClip:     opts.HasClip,
+		maskMode:    opts.MaskMode,
 		calls:       make([]*drawCall, 0, defaultCallsCapacity),
 	}
 }
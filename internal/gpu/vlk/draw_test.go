@@ -0,0 +1,69 @@
+package vlk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vulkan-go/vulkan"
+)
+
+func TestDrawGroup_sameOptions(t *testing.T) {
+	base := DrawOptions{
+		PolygonMode: vulkan.PolygonModeFill,
+		BlendMode:   BlendModeAlpha,
+	}
+
+	with := func(apply func(opts *DrawOptions)) DrawOptions {
+		opts := base
+		apply(&opts)
+		return opts
+	}
+
+	tests := []struct {
+		name  string
+		opts  DrawOptions
+		split bool
+	}{
+		{name: "same options", opts: base, split: false},
+		{name: "blend mode additive", opts: with(func(o *DrawOptions) { o.BlendMode = BlendModeAdditive }), split: true},
+		{name: "blend mode replace", opts: with(func(o *DrawOptions) { o.BlendMode = BlendModeReplace }), split: true},
+		{name: "polygon mode", opts: with(func(o *DrawOptions) { o.PolygonMode = vulkan.PolygonModeLine }), split: true},
+		{name: "texture", opts: with(func(o *DrawOptions) { o.Texture = 1 }), split: true},
+		{name: "clip", opts: with(func(o *DrawOptions) { o.Clip.Extent = vulkan.Extent2D{Width: 10, Height: 10} }), split: true},
+		{name: "mask mode", opts: with(func(o *DrawOptions) { o.MaskMode = MaskModeInside }), split: true},
+		{name: "has clip", opts: with(func(o *DrawOptions) { o.HasClip = true }), split: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			group := newDrawGroup(nil, base)
+			assert.Equal(t, tt.split, !group.sameOptions(tt.opts))
+		})
+	}
+}
+
+func TestGroupScissor(t *testing.T) {
+	surface := vulkan.Rect2D{Extent: vulkan.Extent2D{Width: 100, Height: 80}}
+
+	rect := func(x, y int32, w, h uint32) vulkan.Rect2D {
+		return vulkan.Rect2D{Offset: vulkan.Offset2D{X: x, Y: y}, Extent: vulkan.Extent2D{Width: w, Height: h}}
+	}
+
+	tests := []struct {
+		name string
+		opts DrawOptions
+		want vulkan.Rect2D
+	}{
+		{name: "without clip", opts: DrawOptions{}, want: surface},
+		{name: "zero clip is not whole surface", opts: DrawOptions{HasClip: true}, want: rect(0, 0, 0, 0)},
+		{name: "clip inside", opts: DrawOptions{HasClip: true, Clip: rect(10, 10, 20, 30)}, want: rect(10, 10, 20, 30)},
+		{name: "clip partially outside", opts: DrawOptions{HasClip: true, Clip: rect(90, 70, 20, 30)}, want: rect(90, 70, 10, 10)},
+		{name: "clip outside", opts: DrawOptions{HasClip: true, Clip: rect(200, 10, 20, 30)}, want: rect(200, 10, 0, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, groupScissor(surface, newDrawGroup(nil, tt.opts)))
+		})
+	}
+}
@@ -26,6 +26,9 @@ type (
 
 		internalBufferLastID bufferID
 		allocatedBuffers     map[bufferID]internalBuffer
+
+		internalImageLastID imageID
+		allocatedImages     map[imageID]internalImage
 	}
 
 	internalBuffer struct {
@@ -52,6 +55,9 @@ func NewAllocator(
 
 		internalBufferLastID: 0,
 		allocatedBuffers:     make(map[bufferID]internalBuffer),
+
+		internalImageLastID: 0,
+		allocatedImages:     make(map[imageID]internalImage),
 	}
 }
 
@@ -60,6 +66,10 @@ func (a *Allocator) Free() {
 		a.destroyBuffer(buff)
 	}
 
+	for _, img := range a.allocatedImages {
+		a.destroyImage(img)
+	}
+
 	a.logger.Debug("freed: memory allocator")
 }
 
@@ -180,3 +190,26 @@ func (a *Allocator) writeBuffer(buff internalBuffer, offset uint32, data []byte)
 	// unmap host memory
 	vulkan.UnmapMemory(a.ld.Ref(), buff.memory)
 }
+
+func (a *Allocator) readBuffer(buff internalBuffer, offset uint32, size uint32) []byte {
+	// map memory region
+	var ptr unsafe.Pointer
+	vulkan.MapMemory(
+		a.ld.Ref(),
+		buff.memory,
+		vulkan.DeviceSize(offset),
+		vulkan.DeviceSize(size),
+		0,
+		&ptr,
+	)
+
+	// coherent is host memory
+	// we can just copy data directly from it
+	data := make([]byte, size)
+	copy(data, unsafe.Slice((*byte)(ptr), size))
+
+	// unmap host memory
+	vulkan.UnmapMemory(a.ld.Ref(), buff.memory)
+
+	return data
+}
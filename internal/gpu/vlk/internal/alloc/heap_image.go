@@ -0,0 +1,226 @@
+package alloc
+
+import (
+	"fmt"
+
+	"github.com/vulkan-go/vulkan"
+)
+
+type ImageAllocation struct {
+	Valid  bool
+	Image  vulkan.Image
+	View   vulkan.ImageView
+	Format vulkan.Format
+	Width  uint32
+	Height uint32
+
+	imgID imageID
+}
+
+type ImageReadback struct {
+	Valid  bool
+	Width  uint32
+	Height uint32
+
+	buffID bufferID
+}
+
+// WriteImage will create new device image with specified size and format,
+// upload data into it, and return ImageAllocation object with image/view
+// refs, that can be bound to sampler descriptors
+//
+// Currently only StorageTargetImmutable is supported for images, so
+// data is written to device once, and image can be only deleted after
+func (h *Heap) WriteImage(data []byte, width, height uint32, format vulkan.Format, target StorageTarget) ImageAllocation {
+	if target != StorageTargetImmutable {
+		panic(fmt.Errorf("unsupported image storageTarget %d, only immutable images can be written", target))
+	}
+
+	img := h.allocator.createImage(
+		width,
+		height,
+		format,
+		vulkan.ImageUsageTransferDstBit|vulkan.ImageUsageSampledBit,
+		vulkan.ImageAspectColorBit,
+	)
+
+	h.writeImmutableImageToDevice(img, data)
+
+	return ImageAllocation{
+		Valid:  true,
+		Image:  img.ref,
+		View:   img.view,
+		Format: img.format,
+		Width:  img.width,
+		Height: img.height,
+		imgID:  img.id,
+	}
+}
+
+// CreateAttachmentImage will create new device image with specified size and
+// format, that can be used as render pass color attachment and sampled in
+// shaders. Image is cleared to transparent color and returned
+// in shader read layout. Image content can be copied to host memory
+func (h *Heap) CreateAttachmentImage(width, height uint32, format vulkan.Format) ImageAllocation {
+	img := h.allocator.createImage(
+		width,
+		height,
+		format,
+		vulkan.ImageUsageColorAttachmentBit|vulkan.ImageUsageSampledBit|vulkan.ImageUsageTransferDstBit|vulkan.ImageUsageTransferSrcBit,
+		vulkan.ImageAspectColorBit,
+	)
+
+	h.allocator.clearImage(img)
+
+	return ImageAllocation{
+		Valid:  true,
+		Image:  img.ref,
+		View:   img.view,
+		Format: img.format,
+		Width:  img.width,
+		Height: img.height,
+		imgID:  img.id,
+	}
+}
+
+// CreateFrameImage will create new device image with specified size and
+// format, that can be used as main render pass color attachment instead
+// of swap chain image (in headless mode). Image content can be copied
+// to host memory. Image is returned in undefined layout
+func (h *Heap) CreateFrameImage(width, height uint32, format vulkan.Format) ImageAllocation {
+	img := h.allocator.createImage(
+		width,
+		height,
+		format,
+		vulkan.ImageUsageColorAttachmentBit|vulkan.ImageUsageTransferSrcBit,
+		vulkan.ImageAspectColorBit,
+	)
+
+	return ImageAllocation{
+		Valid:  true,
+		Image:  img.ref,
+		View:   img.view,
+		Format: img.format,
+		Width:  img.width,
+		Height: img.height,
+		imgID:  img.id,
+	}
+}
+
+// CreateStencilImage will create new device image with specified size and
+// depth/stencil format, that can be used as main render pass depth/stencil
+// attachment. Content is cleared by render pass, so image is returned
+// in undefined layout
+func (h *Heap) CreateStencilImage(width, height uint32, format vulkan.Format) ImageAllocation {
+	img := h.allocator.createImage(
+		width,
+		height,
+		format,
+		vulkan.ImageUsageDepthStencilAttachmentBit,
+		vulkan.ImageAspectDepthBit|vulkan.ImageAspectStencilBit,
+	)
+
+	return ImageAllocation{
+		Valid:  true,
+		Image:  img.ref,
+		View:   img.view,
+		Format: img.format,
+		Width:  img.width,
+		Height: img.height,
+		imgID:  img.id,
+	}
+}
+
+// ReadImage will copy rect of device image pixels into host memory
+// and return them as raw bytes (4 bytes per pixel, row by row, in
+// image format components order). Image should be in specified layout
+// and not used by GPU at this moment. Image should be created with
+// transfer src usage
+func (h *Heap) ReadImage(image vulkan.Image, layout vulkan.ImageLayout, rect vulkan.Rect2D) []byte {
+	return h.allocator.readImage(image, layout, rect)
+}
+
+// CreateImageReadback will create host visible buffer, that can hold
+// all pixels of image with specified size (4 bytes per pixel)
+func (h *Heap) CreateImageReadback(width, height uint32) ImageReadback {
+	const bytesPerPixel = 4
+
+	buff := h.allocator.createBuffer(
+		width*height*bytesPerPixel,
+		vulkan.BufferUsageTransferDstBit,
+		vulkan.MemoryPropertyHostVisibleBit|vulkan.MemoryPropertyHostCoherentBit,
+	)
+
+	return ImageReadback{
+		Valid:  true,
+		Width:  width,
+		Height: height,
+		buffID: buff.id,
+	}
+}
+
+// RecordImageReadback will record copy of all image pixels into readback
+// buffer. This allows to read image, that not owned by host after
+// command buffer is submitted (like presented swap chain images).
+// Image size should match readback size, image is returned back
+// into specified layout
+func (h *Heap) RecordImageReadback(cb vulkan.CommandBuffer, image vulkan.Image, layout vulkan.ImageLayout, rb ImageReadback) {
+	buff := h.allocator.allocatedBuffers[rb.buffID]
+
+	recordImageCopy(cb, image, layout, buff.ref, vulkan.Rect2D{
+		Extent: vulkan.Extent2D{Width: rb.Width, Height: rb.Height},
+	})
+}
+
+// ReadImageReadback will return rect of pixels, copied into readback
+// buffer by last RecordImageReadback. GPU should finish command
+// buffer with this copy, before this function is called
+func (h *Heap) ReadImageReadback(rb ImageReadback, rect vulkan.Rect2D) []byte {
+	const bytesPerPixel = 4
+
+	buff := h.allocator.allocatedBuffers[rb.buffID]
+	pixels := h.allocator.readBuffer(buff, 0, rb.Width*rb.Height*bytesPerPixel)
+
+	return cropPixels(pixels, rb.Width, rect)
+}
+
+// FreeImageReadback will destroy readback buffer
+func (h *Heap) FreeImageReadback(rb ImageReadback) {
+	buff, exist := h.allocator.allocatedBuffers[rb.buffID]
+	if !exist {
+		panic(fmt.Errorf("failed free image readback: buffer with id %d not exist in heap", rb.buffID))
+	}
+
+	h.allocator.destroyBuffer(buff)
+}
+
+// FreeImage will destroy image and release its device memory
+// Calling this function many times (or with invalid ImageAllocation object) will
+// panic
+func (h *Heap) FreeImage(alloc ImageAllocation) {
+	img, exist := h.allocator.allocatedImages[alloc.imgID]
+	if !exist {
+		panic(fmt.Errorf("failed free image: image with id %d not exist in heap", alloc.imgID))
+	}
+
+	h.allocator.destroyImage(img)
+}
+
+func (h *Heap) writeImmutableImageToDevice(img internalImage, data []byte) {
+	// create tmp buffer, visible from CPU/GPU side
+	tmpBuffer := h.allocator.createBuffer(
+		uint32(len(data)),
+		vulkan.BufferUsageTransferSrcBit,
+		vulkan.MemoryPropertyHostVisibleBit|vulkan.MemoryPropertyHostCoherentBit,
+	)
+
+	// copy data to it
+	h.allocator.writeBuffer(tmpBuffer, 0, data)
+
+	// copy data from tmp to fast device image memory
+	h.allocator.copyBufferToImage(tmpBuffer, img)
+
+	// drop tmp buffer, image is immutable, so we
+	// not need it anymore
+	h.allocator.destroyBuffer(tmpBuffer)
+}
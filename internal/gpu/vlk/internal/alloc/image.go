@@ -0,0 +1,344 @@
+package alloc
+
+import (
+	"fmt"
+
+	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/must"
+)
+
+type (
+	imageID uint32
+
+	internalImage struct {
+		id     imageID
+		ref    vulkan.Image
+		view   vulkan.ImageView
+		memory vulkan.DeviceMemory
+		format vulkan.Format
+		width  uint32
+		height uint32
+	}
+)
+
+func (a *Allocator) destroyImage(img internalImage) {
+	vulkan.DestroyImageView(a.ld.Ref(), img.view, nil)
+	vulkan.DestroyImage(a.ld.Ref(), img.ref, nil)
+	vulkan.FreeMemory(a.ld.Ref(), img.memory, nil)
+
+	delete(a.allocatedImages, img.id)
+	a.logger.Debug(fmt.Sprintf("freed: image %d", img.id))
+}
+
+func (a *Allocator) createImage(width, height uint32, format vulkan.Format, usage vulkan.ImageUsageFlagBits, aspect vulkan.ImageAspectFlagBits) internalImage {
+	info := &vulkan.ImageCreateInfo{
+		SType:     vulkan.StructureTypeImageCreateInfo,
+		ImageType: vulkan.ImageType2d,
+		Format:    format,
+		Extent: vulkan.Extent3D{
+			Width:  width,
+			Height: height,
+			Depth:  1,
+		},
+		MipLevels:     1,
+		ArrayLayers:   1,
+		Samples:       vulkan.SampleCount1Bit,
+		Tiling:        vulkan.ImageTilingOptimal,
+		Usage:         vulkan.ImageUsageFlags(usage),
+		SharingMode:   vulkan.SharingModeExclusive,
+		InitialLayout: vulkan.ImageLayoutUndefined,
+	}
+
+	var image vulkan.Image
+	must.Work(vulkan.CreateImage(a.ld.Ref(), info, nil, &image))
+
+	// get device memory requirements for it
+	var memoryReq vulkan.MemoryRequirements
+	vulkan.GetImageMemoryRequirements(a.ld.Ref(), image, &memoryReq)
+	memoryReq.Deref()
+
+	memoryTypeIndex := findBufferWithMemoryType(
+		a.pd,
+		memoryReq,
+		vulkan.MemoryPropertyFlags(vulkan.MemoryPropertyDeviceLocalBit),
+	)
+
+	memAllocInfo := &vulkan.MemoryAllocateInfo{
+		SType:           vulkan.StructureTypeMemoryAllocateInfo,
+		AllocationSize:  memoryReq.Size,
+		MemoryTypeIndex: memoryTypeIndex,
+	}
+
+	var imageMemory vulkan.DeviceMemory
+	must.Work(vulkan.AllocateMemory(a.ld.Ref(), memAllocInfo, nil, &imageMemory))
+
+	vulkan.BindImageMemory(a.ld.Ref(), image, imageMemory, 0)
+
+	// create view, shaders can access image only through it
+	viewInfo := &vulkan.ImageViewCreateInfo{
+		SType:    vulkan.StructureTypeImageViewCreateInfo,
+		Image:    image,
+		ViewType: vulkan.ImageViewType2d,
+		Format:   format,
+		Components: vulkan.ComponentMapping{
+			R: vulkan.ComponentSwizzleIdentity,
+			G: vulkan.ComponentSwizzleIdentity,
+			B: vulkan.ComponentSwizzleIdentity,
+			A: vulkan.ComponentSwizzleIdentity,
+		},
+		SubresourceRange: subresourceRange(aspect),
+	}
+
+	var view vulkan.ImageView
+	must.Work(vulkan.CreateImageView(a.ld.Ref(), viewInfo, nil, &view))
+
+	a.internalImageLastID++
+	internalImg := internalImage{
+		id:     a.internalImageLastID,
+		ref:    image,
+		view:   view,
+		memory: imageMemory,
+		format: format,
+		width:  width,
+		height: height,
+	}
+
+	a.allocatedImages[internalImg.id] = internalImg
+	a.logger.Debug(fmt.Sprintf("Image %d (%dx%d) with %.3fKB size - allocated",
+		internalImg.id,
+		width,
+		height,
+		float64(memoryReq.Size/1024)),
+	)
+
+	return internalImg
+}
+
+// copyBufferToImage will copy all src buffer data into dst image
+// and transition image into shader read layout, so it can
+// be sampled right after this call
+func (a *Allocator) copyBufferToImage(src internalBuffer, dst internalImage) {
+	a.pool.TemporaryBuffer(func(cb vulkan.CommandBuffer) {
+		transitionImageLayout(cb, dst.ref,
+			vulkan.ImageLayoutUndefined,
+			vulkan.ImageLayoutTransferDstOptimal,
+		)
+
+		region := vulkan.BufferImageCopy{
+			BufferOffset:      0,
+			BufferRowLength:   0, // tightly packed
+			BufferImageHeight: 0, // tightly packed
+			ImageSubresource: vulkan.ImageSubresourceLayers{
+				AspectMask:     vulkan.ImageAspectFlags(vulkan.ImageAspectColorBit),
+				MipLevel:       0,
+				BaseArrayLayer: 0,
+				LayerCount:     1,
+			},
+			ImageOffset: vulkan.Offset3D{X: 0, Y: 0, Z: 0},
+			ImageExtent: vulkan.Extent3D{
+				Width:  dst.width,
+				Height: dst.height,
+				Depth:  1,
+			},
+		}
+
+		vulkan.CmdCopyBufferToImage(cb, src.ref, dst.ref, vulkan.ImageLayoutTransferDstOptimal, 1, []vulkan.BufferImageCopy{region})
+
+		transitionImageLayout(cb, dst.ref,
+			vulkan.ImageLayoutTransferDstOptimal,
+			vulkan.ImageLayoutShaderReadOnlyOptimal,
+		)
+
+		a.logger.Debug(fmt.Sprintf("image data copied (buffer %d -> image %d), size=%dx%d",
+			src.id,
+			dst.id,
+			dst.width,
+			dst.height,
+		))
+	})
+}
+
+// clearImage will fill all dst image pixels with transparent black color
+// and transition image into shader read layout, so it can
+// be sampled (or used as render pass attachment) right after this call
+func (a *Allocator) clearImage(dst internalImage) {
+	a.pool.TemporaryBuffer(func(cb vulkan.CommandBuffer) {
+		transitionImageLayout(cb, dst.ref,
+			vulkan.ImageLayoutUndefined,
+			vulkan.ImageLayoutTransferDstOptimal,
+		)
+
+		var clearColor vulkan.ClearColorValue // zero bytes is (0,0,0,0) color
+		vulkan.CmdClearColorImage(cb, dst.ref, vulkan.ImageLayoutTransferDstOptimal, &clearColor, 1, []vulkan.ImageSubresourceRange{
+			colorSubresourceRange(),
+		})
+
+		transitionImageLayout(cb, dst.ref,
+			vulkan.ImageLayoutTransferDstOptimal,
+			vulkan.ImageLayoutShaderReadOnlyOptimal,
+		)
+
+		a.logger.Debug(fmt.Sprintf("image %d cleared, size=%dx%d",
+			dst.id,
+			dst.width,
+			dst.height,
+		))
+	})
+}
+
+// readImage will copy rect of src image pixels into temporary host
+// buffer and return them as raw bytes (4 bytes per pixel, row by row)
+// Image should be in specified layout, and not used by GPU at
+// this moment. After copy, image is returned back to same layout
+func (a *Allocator) readImage(src vulkan.Image, layout vulkan.ImageLayout, rect vulkan.Rect2D) []byte {
+	const bytesPerPixel = 4
+	size := rect.Extent.Width * rect.Extent.Height * bytesPerPixel
+
+	// create tmp buffer, visible from CPU side
+	tmpBuffer := a.createBuffer(
+		size,
+		vulkan.BufferUsageTransferDstBit,
+		vulkan.MemoryPropertyHostVisibleBit|vulkan.MemoryPropertyHostCoherentBit,
+	)
+
+	a.pool.TemporaryBuffer(func(cb vulkan.CommandBuffer) {
+		recordImageCopy(cb, src, layout, tmpBuffer.ref, rect)
+	})
+
+	data := a.readBuffer(tmpBuffer, 0, size)
+	a.destroyBuffer(tmpBuffer)
+
+	a.logger.Debug(fmt.Sprintf("image data read (%dx%d at %d,%d), size=%.2fKB",
+		rect.Extent.Width,
+		rect.Extent.Height,
+		rect.Offset.X,
+		rect.Offset.Y,
+		float32(size)/1024,
+	))
+
+	return data
+}
+
+// recordImageCopy will record copy of image rect into buffer (tightly
+// packed, row by row). Image is returned back into specified layout
+func recordImageCopy(cb vulkan.CommandBuffer, src vulkan.Image, layout vulkan.ImageLayout, dst vulkan.Buffer, rect vulkan.Rect2D) {
+	if layout != vulkan.ImageLayoutTransferSrcOptimal {
+		transitionImageLayout(cb, src, layout, vulkan.ImageLayoutTransferSrcOptimal)
+	}
+
+	region := vulkan.BufferImageCopy{
+		BufferOffset:      0,
+		BufferRowLength:   0, // tightly packed
+		BufferImageHeight: 0, // tightly packed
+		ImageSubresource: vulkan.ImageSubresourceLayers{
+			AspectMask:     vulkan.ImageAspectFlags(vulkan.ImageAspectColorBit),
+			MipLevel:       0,
+			BaseArrayLayer: 0,
+			LayerCount:     1,
+		},
+		ImageOffset: vulkan.Offset3D{X: rect.Offset.X, Y: rect.Offset.Y, Z: 0},
+		ImageExtent: vulkan.Extent3D{
+			Width:  rect.Extent.Width,
+			Height: rect.Extent.Height,
+			Depth:  1,
+		},
+	}
+
+	vulkan.CmdCopyImageToBuffer(cb, src, vulkan.ImageLayoutTransferSrcOptimal, dst, 1, []vulkan.BufferImageCopy{region})
+
+	if layout != vulkan.ImageLayoutTransferSrcOptimal {
+		transitionImageLayout(cb, src, vulkan.ImageLayoutTransferSrcOptimal, layout)
+	}
+}
+
+// cropPixels will copy rect from tightly packed pixels
+// of image with specified width (4 bytes per pixel)
+func cropPixels(pixels []byte, width uint32, rect vulkan.Rect2D) []byte {
+	const bytesPerPixel = 4
+
+	stride := int(width) * bytesPerPixel
+	rowSize := int(rect.Extent.Width) * bytesPerPixel
+	data := make([]byte, 0, rowSize*int(rect.Extent.Height))
+
+	for y := 0; y < int(rect.Extent.Height); y++ {
+		start := (int(rect.Offset.Y)+y)*stride + int(rect.Offset.X)*bytesPerPixel
+		data = append(data, pixels[start:start+rowSize]...)
+	}
+
+	return data
+}
+
+func transitionImageLayout(cb vulkan.CommandBuffer, image vulkan.Image, oldLayout, newLayout vulkan.ImageLayout) {
+	barrier := vulkan.ImageMemoryBarrier{
+		SType:               vulkan.StructureTypeImageMemoryBarrier,
+		OldLayout:           oldLayout,
+		NewLayout:           newLayout,
+		SrcQueueFamilyIndex: vulkan.QueueFamilyIgnored,
+		DstQueueFamilyIndex: vulkan.QueueFamilyIgnored,
+		Image:               image,
+		SubresourceRange:    colorSubresourceRange(),
+	}
+
+	var srcStage, dstStage vulkan.PipelineStageFlagBits
+
+	switch {
+	case oldLayout == vulkan.ImageLayoutUndefined && newLayout == vulkan.ImageLayoutTransferDstOptimal:
+		barrier.SrcAccessMask = 0
+		barrier.DstAccessMask = vulkan.AccessFlags(vulkan.AccessTransferWriteBit)
+		srcStage = vulkan.PipelineStageTopOfPipeBit
+		dstStage = vulkan.PipelineStageTransferBit
+	case oldLayout == vulkan.ImageLayoutTransferDstOptimal && newLayout == vulkan.ImageLayoutShaderReadOnlyOptimal:
+		barrier.SrcAccessMask = vulkan.AccessFlags(vulkan.AccessTransferWriteBit)
+		barrier.DstAccessMask = vulkan.AccessFlags(vulkan.AccessShaderReadBit)
+		srcStage = vulkan.PipelineStageTransferBit
+		dstStage = vulkan.PipelineStageFragmentShaderBit
+	case oldLayout == vulkan.ImageLayoutShaderReadOnlyOptimal && newLayout == vulkan.ImageLayoutTransferSrcOptimal:
+		barrier.SrcAccessMask = vulkan.AccessFlags(vulkan.AccessShaderReadBit | vulkan.AccessColorAttachmentWriteBit)
+		barrier.DstAccessMask = vulkan.AccessFlags(vulkan.AccessTransferReadBit)
+		srcStage = vulkan.PipelineStageFragmentShaderBit | vulkan.PipelineStageColorAttachmentOutputBit
+		dstStage = vulkan.PipelineStageTransferBit
+	case oldLayout == vulkan.ImageLayoutTransferSrcOptimal && newLayout == vulkan.ImageLayoutShaderReadOnlyOptimal:
+		barrier.SrcAccessMask = vulkan.AccessFlags(vulkan.AccessTransferReadBit)
+		barrier.DstAccessMask = vulkan.AccessFlags(vulkan.AccessShaderReadBit)
+		srcStage = vulkan.PipelineStageTransferBit
+		dstStage = vulkan.PipelineStageFragmentShaderBit
+	case oldLayout == vulkan.ImageLayoutPresentSrc && newLayout == vulkan.ImageLayoutTransferSrcOptimal:
+		// image is just rendered in main pass (in same frame)
+		barrier.SrcAccessMask = vulkan.AccessFlags(vulkan.AccessColorAttachmentWriteBit)
+		barrier.DstAccessMask = vulkan.AccessFlags(vulkan.AccessTransferReadBit)
+		srcStage = vulkan.PipelineStageColorAttachmentOutputBit
+		dstStage = vulkan.PipelineStageTransferBit
+	case oldLayout == vulkan.ImageLayoutTransferSrcOptimal && newLayout == vulkan.ImageLayoutPresentSrc:
+		barrier.SrcAccessMask = vulkan.AccessFlags(vulkan.AccessTransferReadBit)
+		barrier.DstAccessMask = vulkan.AccessFlags(vulkan.AccessMemoryReadBit)
+		srcStage = vulkan.PipelineStageTransferBit
+		dstStage = vulkan.PipelineStageBottomOfPipeBit
+	default:
+		panic(fmt.Errorf("unsupported image layout transition %d -> %d", oldLayout, newLayout))
+	}
+
+	vulkan.CmdPipelineBarrier(
+		cb,
+		vulkan.PipelineStageFlags(srcStage),
+		vulkan.PipelineStageFlags(dstStage),
+		0,
+		0, nil,
+		0, nil,
+		1, []vulkan.ImageMemoryBarrier{barrier},
+	)
+}
+
+func colorSubresourceRange() vulkan.ImageSubresourceRange {
+	return subresourceRange(vulkan.ImageAspectColorBit)
+}
+
+func subresourceRange(aspect vulkan.ImageAspectFlagBits) vulkan.ImageSubresourceRange {
+	return vulkan.ImageSubresourceRange{
+		AspectMask:     vulkan.ImageAspectFlags(aspect),
+		BaseMipLevel:   0,
+		LevelCount:     1,
+		BaseArrayLayer: 0,
+		LayerCount:     1,
+	}
+}
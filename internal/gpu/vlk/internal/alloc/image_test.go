@@ -0,0 +1,41 @@
+package alloc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vulkan-go/vulkan"
+)
+
+func Test_cropPixels(t *testing.T) {
+	// 3x2 image, every pixel is [x, y, x, y]
+	pixels := []byte{
+		0, 0, 0, 0, 1, 0, 1, 0, 2, 0, 2, 0,
+		0, 1, 0, 1, 1, 1, 1, 1, 2, 1, 2, 1,
+	}
+
+	rect := func(x, y int32, w, h uint32) vulkan.Rect2D {
+		return vulkan.Rect2D{
+			Offset: vulkan.Offset2D{X: x, Y: y},
+			Extent: vulkan.Extent2D{Width: w, Height: h},
+		}
+	}
+
+	tests := []struct {
+		name string
+		rect vulkan.Rect2D
+		want []byte
+	}{
+		{name: "whole image", rect: rect(0, 0, 3, 2), want: pixels},
+		{name: "one pixel", rect: rect(2, 1, 1, 1), want: []byte{2, 1, 2, 1}},
+		{name: "row", rect: rect(1, 0, 2, 1), want: []byte{1, 0, 1, 0, 2, 0, 2, 0}},
+		{name: "column", rect: rect(1, 0, 1, 2), want: []byte{1, 0, 1, 0, 1, 1, 1, 1}},
+		{name: "empty", rect: rect(1, 1, 0, 0), want: []byte{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, cropPixels(pixels, 3, tt.rect))
+		})
+	}
+}
@@ -121,3 +121,19 @@ const BufferStorageSizeBytes = 32 * 1024 * 1024
 // in one draw-call. So, when we want draw 100k instances
 // library will use 2 draw calls.
 const BufferIndexMaxInstances = 65536
+
+// MaxSetsPerFrame how many descriptor sets of one layout can be
+// used in one frame. Every surface use own global set, and every
+// draw call with storage data use own object set
+//
+// Recommended value:
+//   - too small = broken objects data in heavy scenes (with many draw calls)
+//   - too big   = more memory for descriptor pool
+//   - 1024      = good in most cases
+const MaxSetsPerFrame = 1024
+
+// MaxStaticSets how many static descriptor sets (one for each
+// resource, like texture) can be allocated in descriptor pool
+//
+// This is limit of simultaneously loaded textures
+const MaxStaticSets = 1024
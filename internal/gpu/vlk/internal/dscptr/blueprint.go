@@ -5,9 +5,10 @@ import (
 )
 
 const (
-	LayoutIndexGlobal layoutIndex = 0
-	LayoutIndexObject layoutIndex = 1
-	LayoutIndexLocal  layoutIndex = 2
+	LayoutIndexGlobal  layoutIndex = 0
+	LayoutIndexObject  layoutIndex = 1
+	LayoutIndexLocal   layoutIndex = 2
+	LayoutIndexTexture layoutIndex = 3
 )
 
 type (
@@ -19,6 +20,7 @@ type (
 		title       string
 		description string
 		bindings    blueprintBindingsMap
+		static      bool // static sets not allocated for every frame, but on demand (one set for each resource)
 	}
 
 	blueprintBinding struct {
@@ -30,7 +32,7 @@ type (
 	blueprintBindingsMap = map[bindingIndex]blueprintBinding
 )
 
-const totalLayouts = 3 // should match count elements in blueprint
+const totalLayouts = 4 // should match count elements in blueprint
 
 var blueprint = blueprintLayoutMap{
 	LayoutIndexGlobal: {
@@ -67,4 +69,15 @@ var blueprint = blueprintLayoutMap{
 			},
 		},
 	},
+	LayoutIndexTexture: {
+		title:       "Texture",
+		description: "Sampled image for frag shader, one static set for each texture",
+		static:      true,
+		bindings: blueprintBindingsMap{
+			0: {
+				descriptorType: vulkan.DescriptorTypeCombinedImageSampler,
+				flags:          vulkan.ShaderStageFragmentBit,
+			},
+		},
+	},
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/vulkan-go/vulkan"
 
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/alloc"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/def"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/logical"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/physical"
 	"github.com/go-glx/vgl/shared/vlkext"
@@ -22,15 +23,19 @@ type (
 
 		layouts                layoutsMap
 		descriptorSets         descriptorSetsMap
+		descriptorSetsUsed     descriptorSetsUsedMap
 		uniformBufferAlignSize uint32
 		storageBufferAlignSize uint32
 		frameAllocations       frameAllocationsMap
 	}
 
 	layoutsMap        map[layoutIndex]vulkan.DescriptorSetLayout
-	setsMap           map[layoutIndex]vulkan.DescriptorSet
+	setsMap           map[layoutIndex][]vulkan.DescriptorSet
 	descriptorSetsMap map[frameID]setsMap
 
+	setsUsedMap           map[layoutIndex]int
+	descriptorSetsUsedMap map[frameID]setsUsedMap
+
 	layoutAllocationsMap map[layoutIndex][]alloc.Allocation
 	frameAllocationsMap  map[frameID]layoutAllocationsMap
 	DescriptorUpdates    map[bindingIndex][]byte
@@ -48,8 +53,6 @@ func NewManager(
 		layouts[index] = initializeLayout(ld.Ref(), bpLayout)
 	}
 
-	sets := initializeDescriptorSets(ld.Ref(), pool.Pool(), layouts)
-
 	return &Manager{
 		logger: logger,
 		ld:     ld,
@@ -57,7 +60,8 @@ func NewManager(
 		pool:   pool,
 
 		layouts:                layouts,
-		descriptorSets:         sets,
+		descriptorSets:         make(descriptorSetsMap),
+		descriptorSetsUsed:     make(descriptorSetsUsedMap),
 		uniformBufferAlignSize: uint32(pd.PrimaryGPU().Props.Limits.MinUniformBufferOffsetAlignment),
 		storageBufferAlignSize: uint32(pd.PrimaryGPU().Props.Limits.MinStorageBufferOffsetAlignment),
 		frameAllocations:       make(frameAllocationsMap),
@@ -80,16 +84,29 @@ func (m *Manager) Layouts() []vulkan.DescriptorSetLayout {
 	return ordered[:]
 }
 
+// ResetFrame will release all sets (and its buffers memory) used in frame
+// should be called once in frame, before any UpdateSet calls, when
+// GPU is not use this frame resources anymore
+func (m *Manager) ResetFrame(frameID frameID) {
+	for index := range blueprint {
+		m.freeMemory(frameID, index)
+	}
+
+	m.descriptorSetsUsed[frameID] = make(setsUsedMap)
+}
+
+// UpdateSet will write updates data into next free set of this frame
+// and return this set. Every call will return different set, so
+// many objects (surfaces, draw calls) can use own data in same frame
 func (m *Manager) UpdateSet(
 	frameID frameID,
 	index layoutIndex,
 	updates DescriptorUpdates,
 ) vulkan.DescriptorSet {
-	// clear before allocated data for this frame,layout
-	m.freeMemory(frameID, index)
+	// get free set for this frame,layout
+	descriptorSet := m.nextFrameSet(frameID, index)
 
 	// prepare set writes
-	descriptorSet := m.descriptorSets[frameID][index]
 	writeSets := make([]vulkan.WriteDescriptorSet, 0, len(updates))
 
 	// group all updates by buffer type
@@ -144,6 +161,53 @@ func (m *Manager) UpdateSet(
 	return descriptorSet
 }
 
+func (m *Manager) nextFrameSet(frameID frameID, index layoutIndex) vulkan.DescriptorSet {
+	if _, exist := m.descriptorSets[frameID]; !exist {
+		m.descriptorSets[frameID] = make(setsMap)
+	}
+
+	if _, exist := m.descriptorSetsUsed[frameID]; !exist {
+		m.descriptorSetsUsed[frameID] = make(setsUsedMap)
+	}
+
+	sets := m.descriptorSets[frameID][index]
+	used := m.descriptorSetsUsed[frameID][index]
+
+	next, needAllocate, overflow := nextSetIndex(used, len(sets), def.MaxSetsPerFrame)
+	if overflow {
+		m.logger.Error(fmt.Sprintf("max descriptor sets per frame of %d is reached for layout %d (%s). Last set will be overridden",
+			def.MaxSetsPerFrame,
+			index,
+			blueprint[index].title,
+		))
+
+		return sets[next]
+	}
+
+	if needAllocate {
+		// all sets already used in this frame, allocate one more
+		// allocated sets will be reused in next frames
+		sets = append(sets, allocateSet(m.ld.Ref(), m.pool.Pool(), m.layouts[index]))
+		m.descriptorSets[frameID][index] = sets
+	}
+
+	m.descriptorSetsUsed[frameID][index] = used + 1
+	return sets[next]
+}
+
+// nextSetIndex return index of set, that should be used by next UpdateSet call
+// in frame, where used - count of already used sets in this frame, allocated -
+// count of sets, allocated for this frame in previous frames, limit - max sets
+// per frame. When all allocated sets is used, new set should be allocated.
+// When limit is reached, last set is returned and will be overridden
+func nextSetIndex(used, allocated, limit int) (next int, needAllocate bool, overflow bool) {
+	if used >= limit {
+		return allocated - 1, false, true
+	}
+
+	return used, used >= allocated, false
+}
+
 func (m *Manager) uniqueBindingTypes(index layoutIndex, updates DescriptorUpdates) map[alloc.BufferType][]bindingIndex {
 	results := make(map[alloc.BufferType][]bindingIndex)
 
@@ -251,3 +315,44 @@ func (m *Manager) freeMemory(frameID frameID, index layoutIndex) {
 func (m *Manager) writeToMemory(frameID frameID, index layoutIndex, alloc alloc.Allocation) {
 	m.frameAllocations[frameID][index] = append(m.frameAllocations[frameID][index], alloc)
 }
+
+// AllocateStaticSet will allocate new descriptor set for static layout
+// static sets is not bound to frames, and live until pool is destroyed,
+// so caller should reuse sets of released resources
+func (m *Manager) AllocateStaticSet(index layoutIndex) vulkan.DescriptorSet {
+	if !blueprint[index].static {
+		panic(fmt.Errorf("failed allocate static set: layout %d (%s) is not static",
+			index,
+			blueprint[index].title,
+		))
+	}
+
+	return allocateSet(m.ld.Ref(), m.pool.Pool(), m.layouts[index])
+}
+
+// UpdateImageSet will write image view with sampler to specified set binding
+func (m *Manager) UpdateImageSet(
+	set vulkan.DescriptorSet,
+	index layoutIndex,
+	binding bindingIndex,
+	view vulkan.ImageView,
+	sampler vulkan.Sampler,
+) {
+	writeSet := vulkan.WriteDescriptorSet{
+		SType:           vulkan.StructureTypeWriteDescriptorSet,
+		DstSet:          set,
+		DstBinding:      binding,
+		DstArrayElement: 0,
+		DescriptorCount: 1,
+		DescriptorType:  blueprint[index].bindings[binding].descriptorType,
+		PImageInfo: []vulkan.DescriptorImageInfo{
+			{
+				Sampler:     sampler,
+				ImageView:   view,
+				ImageLayout: vulkan.ImageLayoutShaderReadOnlyOptimal,
+			},
+		},
+	}
+
+	vulkan.UpdateDescriptorSets(m.ld.Ref(), 1, []vulkan.WriteDescriptorSet{writeSet}, 0, nil)
+}
@@ -29,22 +29,6 @@ func initializeLayout(ld vulkan.Device, bpLayout blueprintLayout) vulkan.Descrip
 	return layout
 }
 
-func initializeDescriptorSets(ld vulkan.Device, pool vulkan.DescriptorPool, layouts layoutsMap) descriptorSetsMap {
-	descriptorSets := make(descriptorSetsMap)
-
-	for frameID := frameID(0); frameID < framesCount; frameID++ {
-		set := make(setsMap)
-
-		for layoutIndex, layout := range layouts {
-			set[layoutIndex] = allocateSet(ld, pool, layout)
-		}
-
-		descriptorSets[frameID] = set
-	}
-
-	return descriptorSets
-}
-
 func allocateSet(ld vulkan.Device, pool vulkan.DescriptorPool, layout vulkan.DescriptorSetLayout) vulkan.DescriptorSet {
 	setAllocateInfo := vulkan.DescriptorSetAllocateInfo{
 		SType:              vulkan.StructureTypeDescriptorSetAllocateInfo,
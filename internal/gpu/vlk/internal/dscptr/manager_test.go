@@ -0,0 +1,110 @@
+package dscptr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/alloc"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/def"
+)
+
+func Test_nextSetIndex(t *testing.T) {
+	tests := []struct {
+		name         string
+		used         int
+		allocated    int
+		limit        int
+		next         int
+		needAllocate bool
+		overflow     bool
+	}{
+		{name: "first call in first frame", used: 0, allocated: 0, limit: 4, next: 0, needAllocate: true},
+		{name: "second call in first frame", used: 1, allocated: 1, limit: 4, next: 1, needAllocate: true},
+		{name: "reuse sets after ResetFrame", used: 0, allocated: 3, limit: 4, next: 0},
+		{name: "reuse last allocated set", used: 2, allocated: 3, limit: 4, next: 2},
+		{name: "allocate up to limit", used: 3, allocated: 3, limit: 4, next: 3, needAllocate: true},
+		{name: "limit reached", used: 4, allocated: 4, limit: 4, next: 3, overflow: true},
+		{name: "limit reached many times", used: 10, allocated: 4, limit: 4, next: 3, overflow: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, needAllocate, overflow := nextSetIndex(tt.used, tt.allocated, tt.limit)
+
+			assert.Equal(t, tt.next, next)
+			assert.Equal(t, tt.needAllocate, needAllocate)
+			assert.Equal(t, tt.overflow, overflow)
+		})
+	}
+}
+
+func Test_nextSetIndex_uniqueSetPerCall(t *testing.T) {
+	// every UpdateSet call in one frame should get own set, otherwise
+	// data of previous draw call will be overridden before GPU read it
+	const calls = 16
+
+	allocated := 0
+	seen := make(map[int]bool)
+
+	for used := 0; used < calls; used++ {
+		next, needAllocate, overflow := nextSetIndex(used, allocated, def.MaxSetsPerFrame)
+		assert.False(t, overflow)
+
+		if needAllocate {
+			allocated++
+		}
+
+		assert.False(t, seen[next], "set %d used twice in frame", next)
+		assert.Less(t, next, allocated)
+		seen[next] = true
+	}
+
+	// next frame (after ResetFrame) should reuse already allocated sets
+	for used := 0; used < calls; used++ {
+		next, needAllocate, _ := nextSetIndex(used, allocated, def.MaxSetsPerFrame)
+		assert.False(t, needAllocate)
+		assert.Equal(t, used, next)
+	}
+}
+
+func Test_poolCapacity(t *testing.T) {
+	perFrame := uint32(framesCount * def.MaxSetsPerFrame)
+
+	maxSets, sizes := poolCapacity(blueprint)
+
+	// global, object and local layouts is per frame, texture is static
+	assert.Equal(t, 3*perFrame+def.MaxStaticSets, maxSets)
+	assert.Equal(t, map[vulkan.DescriptorType]uint32{
+		vulkan.DescriptorTypeUniformBuffer:        2*perFrame + perFrame, // global (2 bindings) + local
+		vulkan.DescriptorTypeStorageBuffer:        perFrame,              // object
+		vulkan.DescriptorTypeCombinedImageSampler: def.MaxStaticSets,     // texture
+	}, sizes)
+}
+
+func TestManager_prepareStaging(t *testing.T) {
+	m := &Manager{
+		uniformBufferAlignSize: 64,
+		storageBufferAlignSize: 16,
+	}
+
+	staging, sizes, offsets := m.prepareStaging(alloc.BufferTypeUniform, [][]byte{
+		make([]byte, 8),
+		make([]byte, 64),
+		make([]byte, 65),
+	})
+
+	assert.Len(t, staging, 64+64+128)
+	assert.Equal(t, []vulkan.DeviceSize{8, 64, 65}, sizes)
+	assert.Equal(t, []vulkan.DeviceSize{0, 64, 128}, offsets)
+
+	staging, sizes, offsets = m.prepareStaging(alloc.BufferTypeStorage, [][]byte{
+		make([]byte, 20),
+		make([]byte, 4),
+	})
+
+	assert.Len(t, staging, 32+16)
+	assert.Equal(t, []vulkan.DeviceSize{20, 4}, sizes)
+	assert.Equal(t, []vulkan.DeviceSize{0, 32}, offsets)
+}
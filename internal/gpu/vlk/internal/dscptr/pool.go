@@ -40,16 +40,7 @@ func (p *Pool) Pool() vulkan.DescriptorPool {
 }
 
 func createPool(logger vlkext.Logger, ld *logical.Device) vulkan.DescriptorPool {
-	bufferTypes := map[vulkan.DescriptorType]uint32{}
-	for _, layout := range blueprint {
-		for _, binding := range layout.bindings {
-			if _, exist := bufferTypes[binding.descriptorType]; !exist {
-				bufferTypes[binding.descriptorType] = 0
-			}
-
-			bufferTypes[binding.descriptorType]++
-		}
-	}
+	maxSets, bufferTypes := poolCapacity(blueprint)
 
 	sizes := make([]vulkan.DescriptorPoolSize, 0, len(bufferTypes))
 	sizesLogs := make([]string, 0, len(bufferTypes))
@@ -63,7 +54,7 @@ func createPool(logger vlkext.Logger, ld *logical.Device) vulkan.DescriptorPool
 
 	info := vulkan.DescriptorPoolCreateInfo{
 		SType:         vulkan.StructureTypeDescriptorPoolCreateInfo,
-		MaxSets:       uint32(framesCount * len(blueprint)),
+		MaxSets:       maxSets,
 		PoolSizeCount: uint32(len(sizes)),
 		PPoolSizes:    sizes,
 	}
@@ -79,3 +70,28 @@ func createPool(logger vlkext.Logger, ld *logical.Device) vulkan.DescriptorPool
 
 	return pool
 }
+
+// poolCapacity calculate how many sets and descriptors of each type
+// pool should have. Not static layouts can have up to MaxSetsPerFrame sets
+// in every frame in flight (each surface and each draw call use own set)
+func poolCapacity(layouts blueprintLayoutMap) (uint32, map[vulkan.DescriptorType]uint32) {
+	bufferTypes := map[vulkan.DescriptorType]uint32{}
+	maxSets := uint32(0)
+
+	for _, layout := range layouts {
+		// static layouts can have one set for each resource (texture, etc..)
+		// other layouts can have many sets in every frame
+		perLayout := uint32(framesCount * def.MaxSetsPerFrame)
+		if layout.static {
+			perLayout = def.MaxStaticSets
+		}
+
+		maxSets += perLayout
+
+		for _, binding := range layout.bindings {
+			bufferTypes[binding.descriptorType] += perLayout
+		}
+	}
+
+	return maxSets, bufferTypes
+}
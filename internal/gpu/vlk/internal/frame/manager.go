@@ -39,7 +39,11 @@ type Manager struct {
 	ld             *logical.Device
 	onSuboptimal   func()
 
-	count uint32
+	count          uint32
+	mainPassActive bool
+	lastImageID    imageID
+	hasLastImage   bool
+	hasCapture     bool
 
 	semRenderAvailable  map[frameID]vulkan.Semaphore
 	semPresentAvailable map[frameID]vulkan.Semaphore
@@ -116,17 +120,18 @@ func (m *Manager) FrameBegin(prev Context) (Context, bool) {
 	}
 
 	// run start commands
+	// main render pass is started lazily, so off-screen passes
+	// can be recorded before it
 	m.commandBufferBegin(ctx)
-
-	// start render pass
-	m.FrameApplyCommands(ctx, func(cb vulkan.CommandBuffer) {
-		m.renderPassMainBegin(uint32(imgID), cb)
-	})
+	m.mainPassActive = false
 
 	// start user space command listening
 	return ctx, true
 }
 
+// FrameApplyCommands will give frame command buffer to apply outside
+// of any render pass. Should not be called after FrameApplyMainCommands
+// in current frame, because main pass is active until FrameEnd
 func (m *Manager) FrameApplyCommands(ctx Context, apply func(cb vulkan.CommandBuffer)) {
 	if !ctx.isAvailable {
 		return
@@ -135,21 +140,70 @@ func (m *Manager) FrameApplyCommands(ctx Context, apply func(cb vulkan.CommandBu
 	apply(m.commandBuffers[ctx.frameID])
 }
 
+// FrameApplyMainCommands will give frame command buffer to apply
+// inside main (screen) render pass
+func (m *Manager) FrameApplyMainCommands(ctx Context, apply func(cb vulkan.CommandBuffer)) {
+	if !ctx.isAvailable {
+		return
+	}
+
+	m.ensureMainPassStarted(ctx)
+	apply(m.commandBuffers[ctx.frameID])
+}
+
+// LastImageID returns swap chain image ID, that was used for
+// rendering in last submitted frame. False is returned, when
+// nothing is rendered yet
+func (m *Manager) LastImageID() (uint32, bool) {
+	return uint32(m.lastImageID), m.hasLastImage
+}
+
+// HasCapture is true, when image of last submitted frame
+// is copied to swap chain capture buffer
+func (m *Manager) HasCapture() bool {
+	return m.hasCapture
+}
+
 func (m *Manager) FrameEnd(ctx Context) {
 	if !ctx.isAvailable {
 		return
 	}
 
-	// end render pass
+	// end render pass (screen will be cleared, even
+	// when nothing is drawn in this frame)
+	m.ensureMainPassStarted(ctx)
 	m.FrameApplyCommands(ctx, func(cb vulkan.CommandBuffer) {
 		m.renderPassMainEnd(cb)
 	})
+	m.mainPassActive = false
+
+	// copy image before it is presented, after present
+	// image is owned by presentation engine
+	captured := m.chain.IsCaptureEnabled()
+	if captured {
+		m.FrameApplyCommands(ctx, func(cb vulkan.CommandBuffer) {
+			m.chain.RecordCapture(cb, int(ctx.imageID))
+		})
+	}
 
 	// end buffer
 	m.commandBufferEnd(ctx)
 
 	// submit rendering on GPU
-	m.submit(ctx.frameID, ctx.imageID)
+	if m.submit(ctx.frameID, ctx.imageID) {
+		m.hasCapture = captured
+	}
+}
+
+func (m *Manager) ensureMainPassStarted(ctx Context) {
+	if m.mainPassActive {
+		return
+	}
+
+	m.FrameApplyCommands(ctx, func(cb vulkan.CommandBuffer) {
+		m.renderPassMainBegin(uint32(ctx.imageID), cb)
+	})
+	m.mainPassActive = true
 }
 
 func (m *Manager) nextFrameID(current frameID) frameID {
@@ -181,6 +235,12 @@ func (m *Manager) waitUntilFenceReady(fence vulkan.Fence) {
 }
 
 func (m *Manager) acquireNextImage(frameID frameID) (imageID, bool) {
+	if m.chain.IsHeadless() {
+		// headless chain has own image for every frame, and frame
+		// fence already guarantees that image is not used by GPU
+		return imageID(frameID), true
+	}
+
 	timeout := uint64(def.FrameAcquireTimeout.Nanoseconds())
 
 	id := uint32(0)
@@ -202,19 +262,27 @@ func (m *Manager) acquireNextImage(frameID frameID) (imageID, bool) {
 	return imageID(id), true
 }
 
-func (m *Manager) submit(frameID frameID, imageID imageID) {
+func (m *Manager) submit(frameID frameID, imageID imageID) bool {
 	if !m.render(frameID) {
-		return
+		return false
 	}
 
-	if !m.present(frameID, imageID) {
-		return
+	m.lastImageID = imageID
+	m.hasLastImage = true
+
+	if !m.chain.IsHeadless() && !m.present(frameID, imageID) {
+		return true
 	}
 
 	vulkan.QueueWaitIdle(m.ld.QueuePresent())
+	return true
 }
 
 func (m *Manager) render(frameID frameID) bool {
+	if m.chain.IsHeadless() {
+		return m.renderHeadless(frameID)
+	}
+
 	info := vulkan.SubmitInfo{
 		SType:                vulkan.StructureTypeSubmitInfo,
 		WaitSemaphoreCount:   1,
@@ -229,6 +297,18 @@ func (m *Manager) render(frameID frameID) bool {
 	return m.notice(vulkan.QueueSubmit(m.ld.QueueGraphics(), 1, []vulkan.SubmitInfo{info}, m.syncFrameBusy[frameID]))
 }
 
+func (m *Manager) renderHeadless(frameID frameID) bool {
+	// image is not acquired and not presented,
+	// so there is nothing to wait and signal
+	info := vulkan.SubmitInfo{
+		SType:              vulkan.StructureTypeSubmitInfo,
+		CommandBufferCount: 1,
+		PCommandBuffers:    []vulkan.CommandBuffer{m.commandBuffers[frameID]},
+	}
+
+	return m.notice(vulkan.QueueSubmit(m.ld.QueueGraphics(), 1, []vulkan.SubmitInfo{info}, m.syncFrameBusy[frameID]))
+}
+
 func (m *Manager) present(frameID frameID, imageID imageID) bool {
 	info := &vulkan.PresentInfo{
 		SType:              vulkan.StructureTypePresentInfo,
@@ -17,12 +17,20 @@ func (m *Manager) renderPassMainBegin(imageID uint32, cb vulkan.CommandBuffer) {
 				Height: m.chain.Props().BufferSize.Height,
 			},
 		},
-		ClearValueCount: 1,
-		PClearValues: []vulkan.ClearValue{
-			{0, 0, 0, 0},
-		},
 	}
 
+	clearValues := []vulkan.ClearValue{
+		{0, 0, 0, 0},
+	}
+
+	if m.mainRenderPass.HasStencil() {
+		// stencil 0 is "not masked"
+		clearValues = append(clearValues, vulkan.NewClearDepthStencil(1, 0))
+	}
+
+	renderPassBeginInfo.ClearValueCount = uint32(len(clearValues))
+	renderPassBeginInfo.PClearValues = clearValues
+
 	vulkan.CmdBeginRenderPass(cb, renderPassBeginInfo, vulkan.SubpassContentsInline)
 }
 
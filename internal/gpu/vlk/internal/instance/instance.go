@@ -47,6 +47,13 @@ func createVk(opt CreateOptions) vulkan.Instance {
 	var inst vulkan.Instance
 	must.Work(vulkan.CreateInstance(&info, nil, &inst))
 
+	// load instance level functions, loader not resolve
+	// them without instance handle
+	err := vulkan.InitInstance(inst)
+	if err != nil {
+		panic(fmt.Errorf("failed init vulkan instance: %w", err))
+	}
+
 	return inst
 }
 
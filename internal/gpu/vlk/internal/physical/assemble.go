@@ -1,6 +1,8 @@
 package physical
 
 import (
+	"math"
+
 	"github.com/vulkan-go/vulkan"
 
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/def"
@@ -23,8 +25,9 @@ func (d *Device) assembleGPU(logger vlkext.Logger, pd vulkan.PhysicalDevice) *GP
 	vulkan.GetPhysicalDeviceMemoryProperties(pd, &memProperties)
 	memProperties.Deref()
 
-	vkExtList := make([]string, 0, len(def.RequiredDeviceExtensions))
-	for _, extName := range def.RequiredDeviceExtensions {
+	requiredExt := d.requiredExtensions()
+	vkExtList := make([]string, 0, len(requiredExt))
+	for _, extName := range requiredExt {
 		vkExtList = append(vkExtList, vkconv.NormalizeString(extName))
 	}
 
@@ -41,7 +44,8 @@ func (d *Device) assembleGPU(logger vlkext.Logger, pd vulkan.PhysicalDevice) *GP
 	if gpu.Families.supportGraphics && gpu.Families.supportPresent {
 		// load another gpu props only if gpu suitable for drawing
 		gpu.Extensions = d.assembleExtensions(pd)
-		gpu.SurfaceProps = d.assembleSurfaceProps(pd)
+		gpu.SurfaceProps = d.assembleSurfaceProps(pd, props)
+		gpu.StencilFormat = d.assembleStencilFormat(pd)
 	}
 
 	return gpu
@@ -69,6 +73,12 @@ func (d *Device) assembleFamilies(device vulkan.PhysicalDevice) Families {
 			result.supportGraphics = true
 		}
 
+		if d.IsHeadless() {
+			// nothing to present, graphics queue
+			// will be used for all operations
+			continue
+		}
+
 		var presentSupport vulkan.Bool32
 		must.Work(vulkan.GetPhysicalDeviceSurfaceSupport(device, uint32(familyId), d.surface.Ref(), &presentSupport))
 
@@ -78,6 +88,11 @@ func (d *Device) assembleFamilies(device vulkan.PhysicalDevice) Families {
 		}
 	}
 
+	if d.IsHeadless() && result.supportGraphics {
+		result.PresentFamilyId = result.GraphicsFamilyId
+		result.supportPresent = true
+	}
+
 	return result
 }
 
@@ -100,7 +115,11 @@ func (d *Device) assembleExtensions(pd vulkan.PhysicalDevice) []vulkan.Extension
 	return result
 }
 
-func (d *Device) assembleSurfaceProps(pd vulkan.PhysicalDevice) SurfaceProps {
+func (d *Device) assembleSurfaceProps(pd vulkan.PhysicalDevice, props vulkan.PhysicalDeviceProperties) SurfaceProps {
+	if d.IsHeadless() {
+		return d.assembleHeadlessSurfaceProps(pd, props)
+	}
+
 	return SurfaceProps{
 		capabilities: d.assembleSurfacePropsCapabilities(pd),
 		formats:      d.assembleSurfacePropsFormats(pd),
@@ -141,3 +160,66 @@ func (d *Device) assembleSurfacePropsPresentModes(device vulkan.PhysicalDevice)
 
 	return presentModes
 }
+
+// assembleHeadlessSurfaceProps emulate surface props, when device
+// not have any surface. Frames will be rendered into own device
+// images, so limits are taken from device itself
+func (d *Device) assembleHeadlessSurfaceProps(pd vulkan.PhysicalDevice, props vulkan.PhysicalDeviceProperties) SurfaceProps {
+	maxSize := props.Limits.MaxImageDimension2D
+
+	return SurfaceProps{
+		capabilities: vulkan.SurfaceCapabilities{
+			MinImageCount:    1,
+			MaxImageCount:    def.OptimalSwapChainBuffersCount,
+			CurrentExtent:    vulkan.Extent2D{Width: math.MaxUint32, Height: math.MaxUint32},
+			MinImageExtent:   vulkan.Extent2D{Width: 1, Height: 1},
+			MaxImageExtent:   vulkan.Extent2D{Width: maxSize, Height: maxSize},
+			CurrentTransform: vulkan.SurfaceTransformIdentityBit,
+		},
+		formats:      d.assembleHeadlessFormats(pd),
+		presentModes: nil,
+	}
+}
+
+func (d *Device) assembleHeadlessFormats(device vulkan.PhysicalDevice) []vulkan.SurfaceFormat {
+	var props vulkan.FormatProperties
+	vulkan.GetPhysicalDeviceFormatProperties(device, def.SurfaceFormat, &props)
+	props.Deref()
+
+	required := vulkan.FormatFeatureFlags(vulkan.FormatFeatureColorAttachmentBit)
+	if props.OptimalTilingFeatures&required != required {
+		return nil
+	}
+
+	return []vulkan.SurfaceFormat{
+		{
+			Format:     def.SurfaceFormat,
+			ColorSpace: def.SurfaceColorSpace,
+		},
+	}
+}
+
+// assembleStencilFormat returns first format with stencil component, that
+// can be used as depth/stencil attachment. Pure stencil formats (S8) has
+// bad support on desktop GPUs, so combined depth/stencil is used
+func (d *Device) assembleStencilFormat(device vulkan.PhysicalDevice) vulkan.Format {
+	candidates := []vulkan.Format{
+		vulkan.FormatD24UnormS8Uint,
+		vulkan.FormatD32SfloatS8Uint,
+		vulkan.FormatD16UnormS8Uint,
+	}
+
+	required := vulkan.FormatFeatureFlags(vulkan.FormatFeatureDepthStencilAttachmentBit)
+
+	for _, format := range candidates {
+		var props vulkan.FormatProperties
+		vulkan.GetPhysicalDeviceFormatProperties(device, format, &props)
+		props.Deref()
+
+		if props.OptimalTilingFeatures&required == required {
+			return format
+		}
+	}
+
+	return vulkan.FormatUndefined
+}
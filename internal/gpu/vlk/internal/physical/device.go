@@ -1,6 +1,7 @@
 package physical
 
 import (
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/def"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/instance"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/surface"
 	"github.com/go-glx/vgl/shared/vlkext"
@@ -28,3 +29,18 @@ func NewDevice(logger vlkext.Logger, inst *instance.Instance, surface *surface.S
 func (d *Device) PrimaryGPU() *GPU {
 	return d.primaryGPU
 }
+
+// IsHeadless is true, when device render frames without
+// window surface, only into off-screen images
+func (d *Device) IsHeadless() bool {
+	return d.surface.IsHeadless()
+}
+
+func (d *Device) requiredExtensions() []string {
+	if d.IsHeadless() {
+		// nothing is presented, so swap chain is not needed
+		return nil
+	}
+
+	return def.RequiredDeviceExtensions
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/vulkan-go/vulkan"
 
-	"github.com/go-glx/vgl/internal/gpu/vlk/internal/def"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/vkconv"
 	"github.com/go-glx/vgl/shared/vlkext"
 )
@@ -21,11 +20,12 @@ type (
 		Extensions         []vulkan.ExtensionProperties
 		Families           Families
 		SurfaceProps       SurfaceProps
+		StencilFormat      vulkan.Format // depth/stencil attachment format (undefined when not supported)
 		RequiredExtensions []string
 	}
 )
 
-func (pd *GPU) isSupportAllRequiredExtensions() bool {
+func (pd *GPU) isSupportAllRequiredExtensions(required []string) bool {
 	supportedExt := make(map[string]any)
 
 	for _, extension := range pd.Extensions {
@@ -34,7 +34,7 @@ func (pd *GPU) isSupportAllRequiredExtensions() bool {
 	}
 
 	notSupported := make([]string, 0)
-	for _, extension := range def.RequiredDeviceExtensions {
+	for _, extension := range required {
 		vkExtName := vkconv.NormalizeString(extension)
 
 		if _, supported := supportedExt[vkExtName]; supported {
@@ -25,7 +25,7 @@ func (d *Device) score(pd *GPU) int {
 			reason:     "window present required for drawing on screen",
 		},
 		{
-			isSuitable: pd.isSupportAllRequiredExtensions(),
+			isSuitable: pd.isSupportAllRequiredExtensions(d.requiredExtensions()),
 			reason:     "gpu should support all required extensions",
 		},
 		{
@@ -33,7 +33,7 @@ func (d *Device) score(pd *GPU) int {
 			reason:     "at least some render formats should exist",
 		},
 		{
-			isSuitable: len(pd.SurfaceProps.presentModes) >= 1,
+			isSuitable: d.IsHeadless() || len(pd.SurfaceProps.presentModes) >= 1,
 			reason:     "at least some present modes should exist",
 		},
 		{
@@ -0,0 +1,81 @@
+package pipeline
+
+import (
+	"fmt"
+
+	"github.com/vulkan-go/vulkan"
+)
+
+const (
+	BlendModeAlpha         BlendMode = iota // default alpha blending: src * src.a + dst * (1 - src.a)
+	BlendModeAdditive                       // src * src.a + dst (particles, light, fire)
+	BlendModeMultiply                       // src * dst + dst * (1 - src.a) (shadows, tinting)
+	BlendModeScreen                         // src * (1 - dst) + dst (soft light)
+	BlendModePremultiplied                  // src + dst * (1 - src.a), src colors already multiplied by alpha
+	BlendModeReplace                        // src (blending is turned off)
+)
+
+type BlendMode uint8
+
+func (m BlendMode) String() string {
+	switch m {
+	case BlendModeAlpha:
+		return "alpha"
+	case BlendModeAdditive:
+		return "additive"
+	case BlendModeMultiply:
+		return "multiply"
+	case BlendModeScreen:
+		return "screen"
+	case BlendModePremultiplied:
+		return "premultiplied"
+	case BlendModeReplace:
+		return "replace"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(m))
+	}
+}
+
+func blendAttachmentState(mode BlendMode) vulkan.PipelineColorBlendAttachmentState {
+	state := vulkan.PipelineColorBlendAttachmentState{
+		BlendEnable:         vulkan.True,
+		SrcColorBlendFactor: vulkan.BlendFactorSrcAlpha,
+		DstColorBlendFactor: vulkan.BlendFactorOneMinusSrcAlpha,
+		ColorBlendOp:        vulkan.BlendOpAdd,
+		SrcAlphaBlendFactor: vulkan.BlendFactorOne,
+		DstAlphaBlendFactor: vulkan.BlendFactorOneMinusSrcAlpha,
+		AlphaBlendOp:        vulkan.BlendOpAdd,
+		ColorWriteMask: vulkan.ColorComponentFlags(
+			vulkan.ColorComponentRBit | vulkan.ColorComponentGBit | vulkan.ColorComponentBBit | vulkan.ColorComponentABit,
+		),
+	}
+
+	switch mode {
+	case BlendModeAlpha:
+		return state
+	case BlendModeAdditive:
+		state.DstColorBlendFactor = vulkan.BlendFactorOne
+		state.DstAlphaBlendFactor = vulkan.BlendFactorOne
+		return state
+	case BlendModeMultiply:
+		state.SrcColorBlendFactor = vulkan.BlendFactorDstColor
+		state.SrcAlphaBlendFactor = vulkan.BlendFactorZero
+		state.DstAlphaBlendFactor = vulkan.BlendFactorOne
+		return state
+	case BlendModeScreen:
+		state.SrcColorBlendFactor = vulkan.BlendFactorOneMinusDstColor
+		state.DstColorBlendFactor = vulkan.BlendFactorOne
+		return state
+	case BlendModePremultiplied:
+		state.SrcColorBlendFactor = vulkan.BlendFactorOne
+		return state
+	case BlendModeReplace:
+		state.BlendEnable = vulkan.False
+		state.SrcColorBlendFactor = vulkan.BlendFactorOne
+		state.DstColorBlendFactor = vulkan.BlendFactorZero
+		state.DstAlphaBlendFactor = vulkan.BlendFactorZero
+		return state
+	default:
+		panic(fmt.Errorf("unknown blend mode %d", mode))
+	}
+}
@@ -0,0 +1,87 @@
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vulkan-go/vulkan"
+)
+
+func Test_blendAttachmentState(t *testing.T) {
+	rgba := vulkan.ColorComponentFlags(
+		vulkan.ColorComponentRBit | vulkan.ColorComponentGBit | vulkan.ColorComponentBBit | vulkan.ColorComponentABit,
+	)
+
+	state := func(enable vulkan.Bool32, srcColor, dstColor, srcAlpha, dstAlpha vulkan.BlendFactor) vulkan.PipelineColorBlendAttachmentState {
+		return vulkan.PipelineColorBlendAttachmentState{
+			BlendEnable:         enable,
+			SrcColorBlendFactor: srcColor,
+			DstColorBlendFactor: dstColor,
+			ColorBlendOp:        vulkan.BlendOpAdd,
+			SrcAlphaBlendFactor: srcAlpha,
+			DstAlphaBlendFactor: dstAlpha,
+			AlphaBlendOp:        vulkan.BlendOpAdd,
+			ColorWriteMask:      rgba,
+		}
+	}
+
+	tests := []struct {
+		mode BlendMode
+		want vulkan.PipelineColorBlendAttachmentState
+	}{
+		{
+			mode: BlendModeAlpha,
+			want: state(vulkan.True,
+				vulkan.BlendFactorSrcAlpha, vulkan.BlendFactorOneMinusSrcAlpha,
+				vulkan.BlendFactorOne, vulkan.BlendFactorOneMinusSrcAlpha,
+			),
+		},
+		{
+			mode: BlendModeAdditive,
+			want: state(vulkan.True,
+				vulkan.BlendFactorSrcAlpha, vulkan.BlendFactorOne,
+				vulkan.BlendFactorOne, vulkan.BlendFactorOne,
+			),
+		},
+		{
+			mode: BlendModeMultiply,
+			want: state(vulkan.True,
+				vulkan.BlendFactorDstColor, vulkan.BlendFactorOneMinusSrcAlpha,
+				vulkan.BlendFactorZero, vulkan.BlendFactorOne,
+			),
+		},
+		{
+			mode: BlendModeScreen,
+			want: state(vulkan.True,
+				vulkan.BlendFactorOneMinusDstColor, vulkan.BlendFactorOne,
+				vulkan.BlendFactorOne, vulkan.BlendFactorOneMinusSrcAlpha,
+			),
+		},
+		{
+			mode: BlendModePremultiplied,
+			want: state(vulkan.True,
+				vulkan.BlendFactorOne, vulkan.BlendFactorOneMinusSrcAlpha,
+				vulkan.BlendFactorOne, vulkan.BlendFactorOneMinusSrcAlpha,
+			),
+		},
+		{
+			mode: BlendModeReplace,
+			want: state(vulkan.False,
+				vulkan.BlendFactorOne, vulkan.BlendFactorZero,
+				vulkan.BlendFactorOne, vulkan.BlendFactorZero,
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			assert.Equal(t, tt.want, blendAttachmentState(tt.mode))
+		})
+	}
+}
+
+func Test_blendAttachmentState_unknown(t *testing.T) {
+	assert.Panics(t, func() {
+		blendAttachmentState(BlendModeReplace + 1)
+	})
+}
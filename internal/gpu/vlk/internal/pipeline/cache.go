@@ -1,36 +1,71 @@
 package pipeline
 
 import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
 	"github.com/vulkan-go/vulkan"
 
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/logical"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/must"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/physical"
 	"github.com/go-glx/vgl/shared/vlkext"
 )
 
+// cacheHeaderSize is size of VkPipelineCacheHeaderVersionOne:
+// headerSize, headerVersion, vendorID, deviceID (uint32 each) and UUID
+const cacheHeaderSize = 4*4 + vulkan.UuidSize
+
 type Cache struct {
 	ref vulkan.PipelineCache
 
-	logger vlkext.Logger
-	ld     *logical.Device
+	logger   vlkext.Logger
+	ld       *logical.Device
+	filePath string // empty - not persisted
 }
 
-func NewCache(logger vlkext.Logger, ld *logical.Device) *Cache {
+func NewCache(logger vlkext.Logger, pd *physical.Device, ld *logical.Device, filePath string) *Cache {
 	info := &vulkan.PipelineCacheCreateInfo{
 		SType: vulkan.StructureTypePipelineCacheCreateInfo,
 	}
 
+	initialData := loadCacheData(logger, pd.PrimaryGPU().Props, filePath)
+	if len(initialData) > 0 {
+		info.InitialDataSize = uint(len(initialData))
+		info.PInitialData = vulkan.Memview(initialData)
+	}
+
 	var cache vulkan.PipelineCache
-	vulkan.CreatePipelineCache(ld.Ref(), info, nil, &cache)
-	logger.Debug("pipeline cache created")
+	result := vulkan.CreatePipelineCache(ld.Ref(), info, nil, &cache)
+	if result != vulkan.Success && len(initialData) > 0 {
+		// driver can reject data even with valid header,
+		// in this case just start from empty cache
+		logger.Notice(fmt.Sprintf("pipeline cache file '%s' rejected by driver (code=%d), ignored", filePath, result))
+
+		initialData = nil
+		info.InitialDataSize = 0
+		info.PInitialData = nil
+		result = vulkan.CreatePipelineCache(ld.Ref(), info, nil, &cache)
+	}
+
+	must.Work(result)
+	logger.Debug(fmt.Sprintf("pipeline cache created (initial data: %d bytes)", len(initialData)))
 
 	return &Cache{
-		ref:    cache,
-		logger: logger,
-		ld:     ld,
+		ref:      cache,
+		logger:   logger,
+		ld:       ld,
+		filePath: filePath,
 	}
 }
 
 func (c *Cache) Free() {
+	c.save()
+
 	vulkan.DestroyPipelineCache(c.ld.Ref(), c.ref, nil)
 	c.logger.Debug("freed: pipeline cache")
 }
@@ -38,3 +73,103 @@ func (c *Cache) Free() {
 func (c *Cache) Ref() vulkan.PipelineCache {
 	return c.ref
 }
+
+func (c *Cache) save() {
+	if c.filePath == "" {
+		return
+	}
+
+	var size uint
+	if vulkan.GetPipelineCacheData(c.ld.Ref(), c.ref, &size, nil) != vulkan.Success || size == 0 {
+		c.logger.Notice("pipeline cache not saved: failed get cache data size")
+		return
+	}
+
+	data := make([]byte, size)
+	if vulkan.GetPipelineCacheData(c.ld.Ref(), c.ref, &size, vulkan.Memview(data)) != vulkan.Success {
+		c.logger.Notice("pipeline cache not saved: failed get cache data")
+		return
+	}
+
+	// write to tmp file first, so crash in the middle of
+	// writing will not leave corrupted cache file
+	tmpPath := c.filePath + ".tmp"
+	err := os.MkdirAll(filepath.Dir(c.filePath), 0o755)
+	if err == nil {
+		err = os.WriteFile(tmpPath, data[:size], 0o644)
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, c.filePath)
+	}
+
+	if err != nil {
+		c.logger.Notice(fmt.Sprintf("pipeline cache not saved to '%s': %v", c.filePath, err))
+		return
+	}
+
+	c.logger.Debug(fmt.Sprintf("pipeline cache saved to '%s' (%d bytes)", c.filePath, size))
+}
+
+func loadCacheData(logger vlkext.Logger, props vulkan.PhysicalDeviceProperties, filePath string) []byte {
+	if filePath == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		// first start, cache will be created on close
+		logger.Debug(fmt.Sprintf("pipeline cache file '%s' not exist yet", filePath))
+		return nil
+	}
+
+	if err != nil {
+		logger.Notice(fmt.Sprintf("pipeline cache file '%s' ignored: %v", filePath, err))
+		return nil
+	}
+
+	err = validateCacheData(data, props.VendorID, props.DeviceID, props.PipelineCacheUUID)
+	if err != nil {
+		logger.Notice(fmt.Sprintf("pipeline cache file '%s' ignored: %v", filePath, err))
+		return nil
+	}
+
+	return data
+}
+
+// validateCacheData check that cache data header is created by
+// same GPU and driver. Driver can crash on foreign data, so
+// it should not be passed into vulkan without this check
+func validateCacheData(data []byte, vendorID, deviceID uint32, uuid [vulkan.UuidSize]byte) error {
+	if len(data) < cacheHeaderSize {
+		return fmt.Errorf("data size %d is less than header size %d", len(data), cacheHeaderSize)
+	}
+
+	headerSize := binary.LittleEndian.Uint32(data[0:4])
+	headerVersion := binary.LittleEndian.Uint32(data[4:8])
+	dataVendorID := binary.LittleEndian.Uint32(data[8:12])
+	dataDeviceID := binary.LittleEndian.Uint32(data[12:16])
+	dataUUID := data[16:cacheHeaderSize]
+
+	if headerSize < cacheHeaderSize || int(headerSize) > len(data) {
+		return fmt.Errorf("invalid header size %d", headerSize)
+	}
+
+	if headerVersion != uint32(vulkan.PipelineCacheHeaderVersionOne) {
+		return fmt.Errorf("unsupported header version %d", headerVersion)
+	}
+
+	if dataVendorID != vendorID || dataDeviceID != deviceID {
+		return fmt.Errorf("created for other GPU (vendor=%#x, device=%#x), current is (vendor=%#x, device=%#x)",
+			dataVendorID,
+			dataDeviceID,
+			vendorID,
+			deviceID,
+		)
+	}
+
+	if !bytes.Equal(dataUUID, uuid[:]) {
+		return fmt.Errorf("created by other driver version (cache UUID mismatch)")
+	}
+
+	return nil
+}
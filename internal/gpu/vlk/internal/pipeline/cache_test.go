@@ -0,0 +1,56 @@
+package pipeline
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vulkan-go/vulkan"
+)
+
+func Test_validateCacheData(t *testing.T) {
+	const vendorID = 0x10de
+	const deviceID = 0x2484
+	uuid := [vulkan.UuidSize]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+
+	header := func(size, version, vendor, device uint32, id [vulkan.UuidSize]byte, payload int) []byte {
+		data := make([]byte, cacheHeaderSize+payload)
+		binary.LittleEndian.PutUint32(data[0:4], size)
+		binary.LittleEndian.PutUint32(data[4:8], version)
+		binary.LittleEndian.PutUint32(data[8:12], vendor)
+		binary.LittleEndian.PutUint32(data[12:16], device)
+		copy(data[16:cacheHeaderSize], id[:])
+		return data
+	}
+
+	otherUUID := uuid
+	otherUUID[15] = 0
+
+	tests := []struct {
+		name  string
+		data  []byte
+		valid bool
+	}{
+		{name: "valid", data: header(cacheHeaderSize, 1, vendorID, deviceID, uuid, 64), valid: true},
+		{name: "valid without payload", data: header(cacheHeaderSize, 1, vendorID, deviceID, uuid, 0), valid: true},
+		{name: "empty", data: []byte{}, valid: false},
+		{name: "truncated header", data: header(cacheHeaderSize, 1, vendorID, deviceID, uuid, 0)[:20], valid: false},
+		{name: "header size too small", data: header(16, 1, vendorID, deviceID, uuid, 64), valid: false},
+		{name: "header size out of data", data: header(4096, 1, vendorID, deviceID, uuid, 64), valid: false},
+		{name: "unknown version", data: header(cacheHeaderSize, 2, vendorID, deviceID, uuid, 64), valid: false},
+		{name: "other vendor", data: header(cacheHeaderSize, 1, 0x1002, deviceID, uuid, 64), valid: false},
+		{name: "other device", data: header(cacheHeaderSize, 1, vendorID, 0x1234, uuid, 64), valid: false},
+		{name: "other driver", data: header(cacheHeaderSize, 1, vendorID, deviceID, otherUUID, 64), valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCacheData(tt.data, vendorID, deviceID, uuid)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
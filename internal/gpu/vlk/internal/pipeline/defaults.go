@@ -10,15 +10,37 @@ func withDefaultLayout() Initializer {
 	}
 }
 
-func withDefaultViewport() Initializer {
-	return func(info *vulkan.GraphicsPipelineCreateInfo, f *Factory) {
+// viewport and scissor is dynamic state, it should be set in
+// command buffer (vkCmdSetViewport, vkCmdSetScissor) before drawing.
+// So pipelines not depend on surface size, and not need rebuild
+// on window resize
+func withDynamicViewport() Initializer {
+	return func(info *vulkan.GraphicsPipelineCreateInfo, _ *Factory) {
 		info.PViewportState = &vulkan.PipelineViewportStateCreateInfo{
 			SType:         vulkan.StructureTypePipelineViewportStateCreateInfo,
 			ViewportCount: 1,
-			PViewports:    []vulkan.Viewport{f.swapChain.Viewport()},
 			ScissorCount:  1,
-			PScissors:     []vulkan.Rect2D{f.swapChain.Scissor()},
 		}
+
+		dynamicStates := []vulkan.DynamicState{
+			vulkan.DynamicStateViewport,
+			vulkan.DynamicStateScissor,
+		}
+
+		info.PDynamicState = &vulkan.PipelineDynamicStateCreateInfo{
+			SType:             vulkan.StructureTypePipelineDynamicStateCreateInfo,
+			DynamicStateCount: uint32(len(dynamicStates)),
+			PDynamicStates:    dynamicStates,
+		}
+	}
+}
+
+// stencil is disabled by default, but state is always set, because
+// main pass has depth/stencil attachment (see WithMask)
+func withDefaultDepthStencil() Initializer {
+	return func(info *vulkan.GraphicsPipelineCreateInfo, _ *Factory) {
+		state := stencilState(MaskModeNone)
+		info.PDepthStencilState = &state
 	}
 }
 
@@ -1,26 +1,27 @@
 package pipeline
 
 import (
+	"fmt"
+
 	"github.com/vulkan-go/vulkan"
 
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/dscptr"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/logical"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/must"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/renderpass"
-	"github.com/go-glx/vgl/internal/gpu/vlk/internal/swapchain"
 	"github.com/go-glx/vgl/shared/vlkext"
 )
 
 type Factory struct {
 	logger             vlkext.Logger
 	ld                 *logical.Device
-	swapChain          *swapchain.Chain
 	mainRenderPass     *renderpass.Pass
 	descriptorsManager *dscptr.Manager
 	cache              *Cache
 
 	defaultPipelineLayout vulkan.PipelineLayout
 	createdPipelines      []vulkan.Pipeline
+	cachedPipelines       map[Key]Info
 }
 
 type Info struct {
@@ -31,7 +32,6 @@ type Info struct {
 func NewFactory(
 	logger vlkext.Logger,
 	ld *logical.Device,
-	swapChain *swapchain.Chain,
 	mainRenderPass *renderpass.Pass,
 	descriptorsManager *dscptr.Manager,
 	cache *Cache,
@@ -39,10 +39,11 @@ func NewFactory(
 	factory := &Factory{
 		logger:             logger,
 		ld:                 ld,
-		swapChain:          swapChain,
 		mainRenderPass:     mainRenderPass,
 		descriptorsManager: descriptorsManager,
 		cache:              cache,
+
+		cachedPipelines: make(map[Key]Info),
 	}
 
 	factory.defaultPipelineLayout = factory.newDefaultPipelineLayout()
@@ -58,18 +59,56 @@ func (f *Factory) Free() {
 		vulkan.DestroyPipeline(f.ld.Ref(), pipeline, nil)
 	}
 
+	for _, info := range f.cachedPipelines {
+		vulkan.DestroyPipeline(f.ld.Ref(), info.Pipeline, nil)
+	}
+
 	f.logger.Debug("freed: pipeline factory")
 }
 
+// CachedPipeline will return already created pipeline with same key,
+// or create new one with options from opts function
+//
+// opts should build pipeline exactly with key state, otherwise
+// cache will return wrong pipeline for some keys. Viewport is
+// dynamic state, so cached pipelines stay valid after window resize
+func (f *Factory) CachedPipeline(key Key, opts func() []Initializer) Info {
+	if info, exist := f.cachedPipelines[key]; exist {
+		return info
+	}
+
+	info := f.createPipeline(opts()...)
+	f.cachedPipelines[key] = info
+
+	f.logger.Debug(fmt.Sprintf("pipeline for shader '%s' created (cached pipelines: %d)",
+		key.Shader,
+		len(f.cachedPipelines),
+	))
+
+	return info
+}
+
+// NewPipeline will create new not cached pipeline, that
+// lives until factory is freed
 func (f *Factory) NewPipeline(opts ...Initializer) Info {
+	info := f.createPipeline(opts...)
+	f.createdPipelines = append(f.createdPipelines, info.Pipeline)
+
+	return info
+}
+
+func (f *Factory) createPipeline(opts ...Initializer) Info {
 	info := vulkan.GraphicsPipelineCreateInfo{
 		SType: vulkan.StructureTypeGraphicsPipelineCreateInfo,
 	}
 
-	// default opts
-	opts = append(opts, withDefaultLayout())
-	opts = append(opts, withDefaultViewport())
-	opts = append(opts, withDefaultMainRenderPass())
+	// default opts (can be overridden by user opts)
+	opts = append([]Initializer{
+		withDefaultLayout(),
+		withDynamicViewport(),
+		withDefaultDepthStencil(),
+		withDefaultMainRenderPass(),
+	}, opts...)
 
 	// build pipeline info
 	for _, applyOpt := range opts {
@@ -89,11 +128,8 @@ func (f *Factory) NewPipeline(opts ...Initializer) Info {
 
 	must.Work(result)
 
-	pipeline := pipelines[0]
-	f.createdPipelines = append(f.createdPipelines, pipeline)
-
 	return Info{
-		Pipeline: pipeline,
+		Pipeline: pipelines[0],
 		Layout:   info.Layout,
 	}
 }
@@ -0,0 +1,21 @@
+package pipeline
+
+import (
+	"github.com/vulkan-go/vulkan"
+)
+
+// Key is full pipeline state, that used for pipelines caching
+// two pipelines with equal keys is interchangeable
+//
+// All fields should be comparable, so Key can be used as map key
+// without any allocations
+type Key struct {
+	Shader        string                     // shader meta ID (vert+frag modules)
+	Topology      vulkan.PrimitiveTopology   // input assembly topology
+	RestartEnable bool                       // input assembly primitive restart
+	PolygonMode   vulkan.PolygonMode         // rasterization mode
+	BlendMode     BlendMode                  // color blending
+	MaskMode      MaskMode                   // stencil mask writing/testing
+	Samples       vulkan.SampleCountFlagBits // multisampling
+	RenderPass    vulkan.RenderPass          // pass, where pipeline will be used
+}
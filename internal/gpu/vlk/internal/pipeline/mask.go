@@ -0,0 +1,115 @@
+package pipeline
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unsafe"
+
+	"github.com/vulkan-go/vulkan"
+)
+
+const (
+	MaskModeNone    MaskMode = iota // stencil is not used
+	MaskModeWrite                   // write 1 into stencil, color is not written
+	MaskModeClear                   // write 0 into stencil, color is not written
+	MaskModeInside                  // draw only where stencil is 1
+	MaskModeOutside                 // draw only where stencil is 0 (inverted mask)
+)
+
+// maskWriteConstantID is fragment shader specialization constant,
+// that is true, when pipeline draws into stencil mask
+const maskWriteConstantID = 0
+
+// maskStencilRef is stencil value of masked pixels
+const maskStencilRef = 1
+
+type MaskMode uint8
+
+func (m MaskMode) String() string {
+	switch m {
+	case MaskModeNone:
+		return "none"
+	case MaskModeWrite:
+		return "write"
+	case MaskModeClear:
+		return "clear"
+	case MaskModeInside:
+		return "inside"
+	case MaskModeOutside:
+		return "outside"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(m))
+	}
+}
+
+// IsWrite is true, when mode draws into stencil mask instead of color
+func (m MaskMode) IsWrite() bool {
+	return m == MaskModeWrite || m == MaskModeClear
+}
+
+func stencilState(mode MaskMode) vulkan.PipelineDepthStencilStateCreateInfo {
+	state := vulkan.PipelineDepthStencilStateCreateInfo{
+		SType:                 vulkan.StructureTypePipelineDepthStencilStateCreateInfo,
+		DepthTestEnable:       vulkan.False,
+		DepthWriteEnable:      vulkan.False,
+		DepthCompareOp:        vulkan.CompareOpAlways,
+		DepthBoundsTestEnable: vulkan.False,
+		StencilTestEnable:     vulkan.False,
+		MinDepthBounds:        0.0,
+		MaxDepthBounds:        1.0,
+	}
+
+	op := vulkan.StencilOpState{
+		FailOp:      vulkan.StencilOpKeep,
+		PassOp:      vulkan.StencilOpKeep,
+		DepthFailOp: vulkan.StencilOpKeep,
+		CompareOp:   vulkan.CompareOpAlways,
+		CompareMask: 0xff,
+		WriteMask:   0,
+		Reference:   maskStencilRef,
+	}
+
+	switch mode {
+	case MaskModeNone:
+		return state
+	case MaskModeWrite:
+		op.PassOp = vulkan.StencilOpReplace
+		op.WriteMask = 0xff
+	case MaskModeClear:
+		op.PassOp = vulkan.StencilOpReplace
+		op.WriteMask = 0xff
+		op.Reference = 0
+	case MaskModeInside:
+		op.CompareOp = vulkan.CompareOpEqual
+	case MaskModeOutside:
+		op.CompareOp = vulkan.CompareOpNotEqual
+	default:
+		panic(fmt.Errorf("unknown mask mode %d", mode))
+	}
+
+	state.StencilTestEnable = vulkan.True
+	state.Front = op
+	state.Back = op
+
+	return state
+}
+
+// maskSpecialization returns fragment shader constants for mask mode
+// shaders can discard transparent pixels, when mask is written
+func maskSpecialization(mode MaskMode) []vulkan.SpecializationInfo {
+	data := make([]byte, 4) // VkBool32
+	if mode.IsWrite() {
+		binary.LittleEndian.PutUint32(data, vulkan.True)
+	}
+
+	return []vulkan.SpecializationInfo{{
+		MapEntryCount: 1,
+		PMapEntries: []vulkan.SpecializationMapEntry{{
+			ConstantID: maskWriteConstantID,
+			Offset:     0,
+			Size:       4,
+		}},
+		DataSize: uint(len(data)),
+		PData:    unsafe.Pointer(&data[0]),
+	}}
+}
@@ -0,0 +1,44 @@
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vulkan-go/vulkan"
+)
+
+func Test_stencilState(t *testing.T) {
+	tests := []struct {
+		mode      MaskMode
+		enabled   bool
+		compare   vulkan.CompareOp
+		pass      vulkan.StencilOp
+		writeMask uint32
+		reference uint32
+	}{
+		{mode: MaskModeNone, enabled: false},
+		{mode: MaskModeWrite, enabled: true, compare: vulkan.CompareOpAlways, pass: vulkan.StencilOpReplace, writeMask: 0xff, reference: 1},
+		{mode: MaskModeClear, enabled: true, compare: vulkan.CompareOpAlways, pass: vulkan.StencilOpReplace, writeMask: 0xff, reference: 0},
+		{mode: MaskModeInside, enabled: true, compare: vulkan.CompareOpEqual, pass: vulkan.StencilOpKeep, writeMask: 0, reference: 1},
+		{mode: MaskModeOutside, enabled: true, compare: vulkan.CompareOpNotEqual, pass: vulkan.StencilOpKeep, writeMask: 0, reference: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			state := stencilState(tt.mode)
+
+			assert.Equal(t, vulkan.Bool32(vulkan.False), state.DepthTestEnable)
+			if !tt.enabled {
+				assert.Equal(t, vulkan.Bool32(vulkan.False), state.StencilTestEnable)
+				return
+			}
+
+			assert.Equal(t, vulkan.Bool32(vulkan.True), state.StencilTestEnable)
+			assert.Equal(t, state.Front, state.Back)
+			assert.Equal(t, tt.compare, state.Front.CompareOp)
+			assert.Equal(t, tt.pass, state.Front.PassOp)
+			assert.Equal(t, tt.writeMask, state.Front.WriteMask)
+			assert.Equal(t, tt.reference, state.Front.Reference)
+		})
+	}
+}
@@ -2,6 +2,8 @@ package pipeline
 
 import (
 	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/renderpass"
 )
 
 type Initializer = func(*vulkan.GraphicsPipelineCreateInfo, *Factory)
@@ -56,11 +58,11 @@ func WithRasterization(mode vulkan.PolygonMode) Initializer {
 	}
 }
 
-func WithMultisampling() Initializer {
+func WithMultisampling(samples vulkan.SampleCountFlagBits) Initializer {
 	return func(info *vulkan.GraphicsPipelineCreateInfo, _ *Factory) {
 		info.PMultisampleState = &vulkan.PipelineMultisampleStateCreateInfo{
 			SType:                 vulkan.StructureTypePipelineMultisampleStateCreateInfo,
-			RasterizationSamples:  vulkan.SampleCount1Bit,
+			RasterizationSamples:  samples,
 			SampleShadingEnable:   vulkan.False,
 			MinSampleShading:      1.0,
 			PSampleMask:           nil,
@@ -70,30 +72,61 @@ func WithMultisampling() Initializer {
 	}
 }
 
-func WithColorBlend() Initializer {
+func WithColorBlend(mode BlendMode) Initializer {
 	return func(info *vulkan.GraphicsPipelineCreateInfo, _ *Factory) {
 		info.PColorBlendState = &vulkan.PipelineColorBlendStateCreateInfo{
 			SType:           vulkan.StructureTypePipelineColorBlendStateCreateInfo,
 			LogicOpEnable:   vulkan.False,
 			LogicOp:         vulkan.LogicOpCopy,
 			AttachmentCount: 1,
-			PAttachments: []vulkan.PipelineColorBlendAttachmentState{{
-				BlendEnable:         vulkan.True,
-				SrcColorBlendFactor: vulkan.BlendFactorSrcAlpha,
-				DstColorBlendFactor: vulkan.BlendFactorOneMinusSrcAlpha,
-				ColorBlendOp:        vulkan.BlendOpAdd,
-				SrcAlphaBlendFactor: vulkan.BlendFactorOne,
-				DstAlphaBlendFactor: vulkan.BlendFactorZero,
-				AlphaBlendOp:        vulkan.BlendOpAdd,
-				ColorWriteMask: vulkan.ColorComponentFlags(
-					vulkan.ColorComponentRBit | vulkan.ColorComponentGBit | vulkan.ColorComponentBBit | vulkan.ColorComponentABit,
-				),
-			}},
-			BlendConstants: [4]float32{0, 0, 0, 0},
+			PAttachments:    []vulkan.PipelineColorBlendAttachmentState{blendAttachmentState(mode)},
+			BlendConstants:  [4]float32{0, 0, 0, 0},
+		}
+	}
+}
+
+// WithMask will set stencil state for mask mode. In write modes, color
+// is not written and fragment shader get maskWrite specialization
+// constant, so it can discard transparent pixels
+//
+// Should be applied after WithStages and WithColorBlend, because
+// it changes their state
+func WithMask(mode MaskMode) Initializer {
+	return func(info *vulkan.GraphicsPipelineCreateInfo, _ *Factory) {
+		state := stencilState(mode)
+		info.PDepthStencilState = &state
+
+		if !mode.IsWrite() {
+			return
+		}
+
+		for ind := range info.PStages {
+			if info.PStages[ind].Stage == vulkan.ShaderStageFragmentBit {
+				info.PStages[ind].PSpecializationInfo = maskSpecialization(mode)
+			}
+		}
+
+		if info.PColorBlendState != nil {
+			attachments := make([]vulkan.PipelineColorBlendAttachmentState, 0, len(info.PColorBlendState.PAttachments))
+			for _, attachment := range info.PColorBlendState.PAttachments {
+				attachment.ColorWriteMask = 0
+				attachments = append(attachments, attachment)
+			}
+
+			info.PColorBlendState.PAttachments = attachments
 		}
 	}
 }
 
+// WithRenderPass will create pipeline for rendering in specified pass
+// instead of main (screen) pass. Used for off-screen rendering
+func WithRenderPass(pass *renderpass.Pass) Initializer {
+	return func(info *vulkan.GraphicsPipelineCreateInfo, _ *Factory) {
+		info.RenderPass = pass.Ref()
+		info.Subpass = 0
+	}
+}
+
 func castToVKBool(b bool) vulkan.Bool32 {
 	if b {
 		return vulkan.True
@@ -11,7 +11,8 @@ import (
 )
 
 type Pass struct {
-	ref vulkan.RenderPass
+	ref           vulkan.RenderPass
+	stencilFormat vulkan.Format // undefined, when pass not have stencil attachment
 
 	ld *logical.Device
 }
@@ -32,6 +33,17 @@ func (p *Pass) Ref() vulkan.RenderPass {
 	return p.ref
 }
 
+// HasStencil is true, when pass have depth/stencil attachment
+// (attachment index 1), so pipelines can use stencil test
+func (p *Pass) HasStencil() bool {
+	return p.stencilFormat != vulkan.FormatUndefined
+}
+
+// StencilFormat is format of depth/stencil attachment
+func (p *Pass) StencilFormat() vulkan.Format {
+	return p.stencilFormat
+}
+
 func createPass(name string, logger vlkext.Logger, ld *logical.Device, attachments []vulkan.AttachmentDescription, subPasses []vulkan.SubpassDescription, dependencies []vulkan.SubpassDependency) vulkan.RenderPass {
 	info := &vulkan.RenderPassCreateInfo{
 		SType:           vulkan.StructureTypeRenderPassCreateInfo,
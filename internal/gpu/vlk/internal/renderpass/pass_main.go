@@ -10,22 +10,42 @@ import (
 
 // NewMain return main render pass that used for rendering
 // buffers to window screen surface
+//
+// In headless mode, image is not presented, so it stays
+// in transfer layout, ready for copy to host memory
+//
+// Pass has stencil attachment (cleared to 0 every frame), used
+// for drawing masks. When GPU not support any stencil format,
+// pass is created without it, and masks is not working
 func NewMain(logger vlkext.Logger, pd *physical.Device, ld *logical.Device) *Pass {
-	return newPass(
+	stencilFormat := pd.PrimaryGPU().StencilFormat
+	if stencilFormat == vulkan.FormatUndefined {
+		logger.Notice("GPU not support stencil attachments, masks will be disabled")
+	}
+
+	pass := newPass(
 		ld,
 		createPass(
 			"main",
 			logger,
 			ld,
-			mainAttachments(pd),
-			mainSubPasses(),
+			mainAttachments(pd, stencilFormat),
+			mainSubPasses(stencilFormat != vulkan.FormatUndefined),
 			mainDependencies(),
 		),
 	)
+
+	pass.stencilFormat = stencilFormat
+	return pass
 }
 
-func mainAttachments(pd *physical.Device) []vulkan.AttachmentDescription {
-	return []vulkan.AttachmentDescription{
+func mainAttachments(pd *physical.Device, stencilFormat vulkan.Format) []vulkan.AttachmentDescription {
+	finalLayout := vulkan.ImageLayoutPresentSrc
+	if pd.IsHeadless() {
+		finalLayout = vulkan.ImageLayoutTransferSrcOptimal
+	}
+
+	attachments := []vulkan.AttachmentDescription{
 		{
 			Format:         pd.PrimaryGPU().SurfaceProps.RichColorSpaceFormat().Format,
 			Samples:        vulkan.SampleCount1Bit,
@@ -34,12 +54,37 @@ func mainAttachments(pd *physical.Device) []vulkan.AttachmentDescription {
 			StencilLoadOp:  vulkan.AttachmentLoadOpDontCare,
 			StencilStoreOp: vulkan.AttachmentStoreOpDontCare,
 			InitialLayout:  vulkan.ImageLayoutUndefined,
-			FinalLayout:    vulkan.ImageLayoutPresentSrc,
+			FinalLayout:    finalLayout,
 		},
 	}
+
+	if stencilFormat == vulkan.FormatUndefined {
+		return attachments
+	}
+
+	// masks live only inside one frame, so stencil
+	// is cleared on start and not stored after pass
+	return append(attachments, vulkan.AttachmentDescription{
+		Format:         stencilFormat,
+		Samples:        vulkan.SampleCount1Bit,
+		LoadOp:         vulkan.AttachmentLoadOpDontCare,
+		StoreOp:        vulkan.AttachmentStoreOpDontCare,
+		StencilLoadOp:  vulkan.AttachmentLoadOpClear,
+		StencilStoreOp: vulkan.AttachmentStoreOpDontCare,
+		InitialLayout:  vulkan.ImageLayoutUndefined,
+		FinalLayout:    vulkan.ImageLayoutDepthStencilAttachmentOptimal,
+	})
 }
 
-func mainSubPasses() []vulkan.SubpassDescription {
+func mainSubPasses(stencil bool) []vulkan.SubpassDescription {
+	var stencilRef *vulkan.AttachmentReference
+	if stencil {
+		stencilRef = &vulkan.AttachmentReference{
+			Attachment: 1,
+			Layout:     vulkan.ImageLayoutDepthStencilAttachmentOptimal,
+		}
+	}
+
 	return []vulkan.SubpassDescription{
 		{
 			PipelineBindPoint:    vulkan.PipelineBindPointGraphics,
@@ -51,7 +96,7 @@ func mainSubPasses() []vulkan.SubpassDescription {
 				Layout:     vulkan.ImageLayoutColorAttachmentOptimal,
 			}},
 			PResolveAttachments:     nil,
-			PDepthStencilAttachment: nil,
+			PDepthStencilAttachment: stencilRef,
 			PreserveAttachmentCount: 0,
 			PPreserveAttachments:    nil,
 		},
@@ -63,10 +108,10 @@ func mainDependencies() []vulkan.SubpassDependency {
 		{
 			SrcSubpass:    vulkan.SubpassExternal,
 			DstSubpass:    0,
-			SrcStageMask:  vulkan.PipelineStageFlags(vulkan.PipelineStageColorAttachmentOutputBit),
-			DstStageMask:  vulkan.PipelineStageFlags(vulkan.PipelineStageColorAttachmentOutputBit),
+			SrcStageMask:  vulkan.PipelineStageFlags(vulkan.PipelineStageColorAttachmentOutputBit | vulkan.PipelineStageEarlyFragmentTestsBit),
+			DstStageMask:  vulkan.PipelineStageFlags(vulkan.PipelineStageColorAttachmentOutputBit | vulkan.PipelineStageEarlyFragmentTestsBit),
 			SrcAccessMask: 0,
-			DstAccessMask: vulkan.AccessFlags(vulkan.AccessColorAttachmentWriteBit),
+			DstAccessMask: vulkan.AccessFlags(vulkan.AccessColorAttachmentWriteBit | vulkan.AccessDepthStencilAttachmentWriteBit),
 		},
 	}
 }
@@ -0,0 +1,70 @@
+package renderpass
+
+import (
+	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/logical"
+	"github.com/go-glx/vgl/shared/vlkext"
+)
+
+// NewOffscreen return render pass that used for rendering
+// into off-screen images (render targets)
+//
+// Attachment content is loaded (not cleared), so target can be
+// rendered in many passes during one frame. Image is always in
+// shader read layout outside of pass, so it can be sampled as
+// texture by next passes
+//
+// Pass not have stencil attachment, so masks is not
+// working on off-screen surfaces
+func NewOffscreen(logger vlkext.Logger, ld *logical.Device, format vulkan.Format) *Pass {
+	return newPass(
+		ld,
+		createPass(
+			"offscreen",
+			logger,
+			ld,
+			offscreenAttachments(format),
+			mainSubPasses(false),
+			offscreenDependencies(),
+		),
+	)
+}
+
+func offscreenAttachments(format vulkan.Format) []vulkan.AttachmentDescription {
+	return []vulkan.AttachmentDescription{
+		{
+			Format:         format,
+			Samples:        vulkan.SampleCount1Bit,
+			LoadOp:         vulkan.AttachmentLoadOpLoad,
+			StoreOp:        vulkan.AttachmentStoreOpStore,
+			StencilLoadOp:  vulkan.AttachmentLoadOpDontCare,
+			StencilStoreOp: vulkan.AttachmentStoreOpDontCare,
+			InitialLayout:  vulkan.ImageLayoutShaderReadOnlyOptimal,
+			FinalLayout:    vulkan.ImageLayoutShaderReadOnlyOptimal,
+		},
+	}
+}
+
+func offscreenDependencies() []vulkan.SubpassDependency {
+	return []vulkan.SubpassDependency{
+		{
+			// wait until previous passes end sampling this image
+			SrcSubpass:    vulkan.SubpassExternal,
+			DstSubpass:    0,
+			SrcStageMask:  vulkan.PipelineStageFlags(vulkan.PipelineStageFragmentShaderBit),
+			DstStageMask:  vulkan.PipelineStageFlags(vulkan.PipelineStageColorAttachmentOutputBit),
+			SrcAccessMask: vulkan.AccessFlags(vulkan.AccessShaderReadBit),
+			DstAccessMask: vulkan.AccessFlags(vulkan.AccessColorAttachmentReadBit | vulkan.AccessColorAttachmentWriteBit),
+		},
+		{
+			// next passes can sample image, only after all writes is done
+			SrcSubpass:    0,
+			DstSubpass:    vulkan.SubpassExternal,
+			SrcStageMask:  vulkan.PipelineStageFlags(vulkan.PipelineStageColorAttachmentOutputBit),
+			DstStageMask:  vulkan.PipelineStageFlags(vulkan.PipelineStageFragmentShaderBit),
+			SrcAccessMask: vulkan.AccessFlags(vulkan.AccessColorAttachmentWriteBit),
+			DstAccessMask: vulkan.AccessFlags(vulkan.AccessShaderReadBit),
+		},
+	}
+}
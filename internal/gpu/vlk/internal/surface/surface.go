@@ -13,7 +13,8 @@ type Surface struct {
 	logger vlkext.Logger
 	inst   *instance.Instance
 
-	ref vulkan.Surface
+	ref      vulkan.Surface
+	headless bool
 }
 
 func NewSurface(logger vlkext.Logger, inst *instance.Instance, wm vlkext.WindowManager) *Surface {
@@ -29,11 +30,29 @@ func NewSurface(logger vlkext.Logger, inst *instance.Instance, wm vlkext.WindowM
 	}
 }
 
+// NewHeadless return surface without any vulkan object behind,
+// used when renderer draws frames only into off-screen images
+func NewHeadless(logger vlkext.Logger, inst *instance.Instance) *Surface {
+	return &Surface{
+		logger:   logger,
+		inst:     inst,
+		headless: true,
+	}
+}
+
 func (s *Surface) Free() {
-	vulkan.DestroySurface(s.inst.Ref(), s.ref, nil)
+	if !s.headless {
+		vulkan.DestroySurface(s.inst.Ref(), s.ref, nil)
+	}
+
 	s.logger.Debug("freed: surface")
 }
 
+// IsHeadless is true, when surface not connected to any window
+func (s *Surface) IsHeadless() bool {
+	return s.headless
+}
+
 func (s *Surface) Ref() vulkan.Surface {
 	return s.ref
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/vulkan-go/vulkan"
 
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/alloc"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/logical"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/physical"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/renderpass"
@@ -20,17 +21,23 @@ type Chain struct {
 	views     []vulkan.ImageView
 	buffers   []vulkan.Framebuffer
 
-	ld *logical.Device
+	ld   *logical.Device
+	heap *alloc.Heap
+
+	allocations []alloc.ImageAllocation // only in headless mode
+	stencil     alloc.ImageAllocation   // shared stencil image (when main pass has stencil)
+	capture     alloc.ImageReadback     // copy of last rendered image (only when capture is enabled)
 }
 
-func NewChain(logger vlkext.Logger, width, height uint32, pd *physical.Device, ld *logical.Device, surface *surface.Surface, mainRenderPass *renderpass.Pass, mobileFriendly bool) *Chain {
+func NewChain(logger vlkext.Logger, width, height uint32, pd *physical.Device, ld *logical.Device, heap *alloc.Heap, surface *surface.Surface, mainRenderPass *renderpass.Pass, mobileFriendly bool) *Chain {
 	props := newProps(width, height, pd, mobileFriendly)
 	sharingMode := deviceSharingMode(pd)
 	swapChain := newSwapChain(pd, ld, surface, props, sharingMode)
 
 	images := createImages(swapChain, ld)
 	views := createViews(images, ld, props)
-	buffers := createFrameBuffers(ld, mainRenderPass.Ref(), props, views)
+	stencil := createStencil(heap, mainRenderPass, props)
+	buffers := createFrameBuffers(ld, mainRenderPass, props, views, stencil)
 
 	logger.Debug(fmt.Sprintf("swapchain created, images=%d, props=(%s)", len(images), props.String()))
 
@@ -42,7 +49,10 @@ func NewChain(logger vlkext.Logger, width, height uint32, pd *physical.Device, l
 		views:     views,
 		buffers:   buffers,
 
-		ld: ld,
+		ld:   ld,
+		heap: heap,
+
+		stencil: stencil,
 	}
 }
 
@@ -51,6 +61,24 @@ func (c *Chain) Free() {
 		vulkan.DestroyFramebuffer(c.ld.Ref(), buffer, nil)
 	}
 
+	if c.stencil.Valid {
+		c.heap.FreeImage(c.stencil)
+	}
+
+	if c.capture.Valid {
+		c.heap.FreeImageReadback(c.capture)
+	}
+
+	if c.props.Headless {
+		// views is owned by allocations
+		for _, allocation := range c.allocations {
+			c.heap.FreeImage(allocation)
+		}
+
+		c.logger.Debug("freed: headless swapchain")
+		return
+	}
+
 	for _, view := range c.views {
 		vulkan.DestroyImageView(c.ld.Ref(), view, nil)
 	}
@@ -59,6 +87,12 @@ func (c *Chain) Free() {
 	c.logger.Debug("freed: swapchain")
 }
 
+// IsHeadless is true, when chain images is not
+// presented on screen (chain not have vk swapchain)
+func (c *Chain) IsHeadless() bool {
+	return c.props.Headless
+}
+
 func (c *Chain) Ref() vulkan.Swapchain {
 	return c.swapChain
 }
@@ -67,6 +101,50 @@ func (c *Chain) Props() ChainProps {
 	return c.props
 }
 
+func (c *Chain) Image(index int) vulkan.Image {
+	return c.images[index]
+}
+
+// ImageLayout is layout of chain images, after
+// main render pass is ended
+func (c *Chain) ImageLayout() vulkan.ImageLayout {
+	if c.props.Headless {
+		return vulkan.ImageLayoutTransferSrcOptimal
+	}
+
+	return vulkan.ImageLayoutPresentSrc
+}
+
+// EnableCapture will allocate capture buffer, after this frame manager
+// will copy every rendered image into it, before image is presented.
+// Presented images is owned by presentation engine, and
+// cannot be read directly
+func (c *Chain) EnableCapture() {
+	if c.capture.Valid || c.props.Headless || !c.props.IsReadable() {
+		return
+	}
+
+	c.capture = c.heap.CreateImageReadback(c.props.BufferSize.Width, c.props.BufferSize.Height)
+	c.logger.Debug("swapchain images capture enabled")
+}
+
+// IsCaptureEnabled is true, when chain images should be
+// copied to capture buffer in every frame
+func (c *Chain) IsCaptureEnabled() bool {
+	return c.capture.Valid
+}
+
+// RecordCapture will record copy of image into capture buffer. Should
+// be called inside frame, after main render pass is ended
+func (c *Chain) RecordCapture(cb vulkan.CommandBuffer, index int) {
+	c.heap.RecordImageReadback(cb, c.images[index], c.ImageLayout(), c.capture)
+}
+
+// ReadCapture will return rect of pixels of last captured image
+func (c *Chain) ReadCapture(rect vulkan.Rect2D) []byte {
+	return c.heap.ReadImageReadback(c.capture, rect)
+}
+
 func (c *Chain) FrameBuffer(index int) vulkan.Framebuffer {
 	return c.buffers[index]
 }
@@ -91,3 +169,13 @@ func (c *Chain) Scissor() vulkan.Rect2D {
 		Extent: c.Props().BufferSize,
 	}
 }
+
+// createStencil will create stencil image for all chain frame
+// buffers, or invalid allocation, when main pass not use stencil
+func createStencil(heap *alloc.Heap, mainRenderPass *renderpass.Pass, props ChainProps) alloc.ImageAllocation {
+	if !mainRenderPass.HasStencil() {
+		return alloc.ImageAllocation{}
+	}
+
+	return heap.CreateStencilImage(props.BufferSize.Width, props.BufferSize.Height, mainRenderPass.StencilFormat())
+}
@@ -0,0 +1,53 @@
+package swapchain
+
+import (
+	"fmt"
+
+	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/alloc"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/logical"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/physical"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/renderpass"
+	"github.com/go-glx/vgl/shared/vlkext"
+)
+
+// NewHeadlessChain will create chain of own device images, that used
+// instead of real swap chain, when renderer work without window surface.
+//
+// Images is never presented, frame manager will just render into
+// them one by one (same as with swap chain images)
+func NewHeadlessChain(logger vlkext.Logger, width, height uint32, pd *physical.Device, ld *logical.Device, heap *alloc.Heap, mainRenderPass *renderpass.Pass) *Chain {
+	props := newHeadlessProps(width, height, pd)
+
+	allocations := make([]alloc.ImageAllocation, 0, props.BuffersCount)
+	images := make([]vulkan.Image, 0, props.BuffersCount)
+	views := make([]vulkan.ImageView, 0, props.BuffersCount)
+
+	for i := uint32(0); i < props.BuffersCount; i++ {
+		allocation := heap.CreateFrameImage(props.BufferSize.Width, props.BufferSize.Height, props.ImageFormat)
+
+		allocations = append(allocations, allocation)
+		images = append(images, allocation.Image)
+		views = append(views, allocation.View)
+	}
+
+	stencil := createStencil(heap, mainRenderPass, props)
+	buffers := createFrameBuffers(ld, mainRenderPass, props, views, stencil)
+
+	logger.Debug(fmt.Sprintf("headless swapchain created, images=%d, props=(%s)", len(images), props.String()))
+
+	return &Chain{
+		logger:  logger,
+		props:   props,
+		images:  images,
+		views:   views,
+		buffers: buffers,
+
+		ld:   ld,
+		heap: heap,
+
+		allocations: allocations,
+		stencil:     stencil,
+	}
+}
@@ -20,7 +20,7 @@ func newSwapChain(pd *physical.Device, ld *logical.Device, surface *surface.Surf
 		ImageColorSpace:       props.ImageColorSpace,
 		ImageExtent:           props.BufferSize,
 		ImageArrayLayers:      1,
-		ImageUsage:            vulkan.ImageUsageFlags(vulkan.ImageUsageColorAttachmentBit),
+		ImageUsage:            props.ImageUsage,
 		ImageSharingMode:      sharingMode,
 		QueueFamilyIndexCount: uint32(len(families)),
 		PQueueFamilyIndices:   families,
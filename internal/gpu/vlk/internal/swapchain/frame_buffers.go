@@ -3,31 +3,39 @@ package swapchain
 import (
 	"github.com/vulkan-go/vulkan"
 
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/alloc"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/logical"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/must"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/renderpass"
 )
 
-func createFrameBuffers(ld *logical.Device, mainRenderPass vulkan.RenderPass, props ChainProps, views []vulkan.ImageView) []vulkan.Framebuffer {
+// createFrameBuffers will create frame buffer for every chain image
+// stencil image is shared between all buffers (it not stored after
+// pass), and should be valid, when main pass has stencil attachment
+func createFrameBuffers(ld *logical.Device, mainRenderPass *renderpass.Pass, props ChainProps, views []vulkan.ImageView, stencil alloc.ImageAllocation) []vulkan.Framebuffer {
 	buffers := make([]vulkan.Framebuffer, 0, len(views))
 
 	for _, view := range views {
-		buffers = append(buffers, createFrameBuffer(ld, mainRenderPass, props, view))
+		attachments := []vulkan.ImageView{view}
+		if mainRenderPass.HasStencil() {
+			attachments = append(attachments, stencil.View)
+		}
+
+		buffers = append(buffers, createFrameBuffer(ld, mainRenderPass.Ref(), props, attachments))
 	}
 
 	return buffers
 }
 
-func createFrameBuffer(ld *logical.Device, mainRenderPass vulkan.RenderPass, props ChainProps, view vulkan.ImageView) vulkan.Framebuffer {
+func createFrameBuffer(ld *logical.Device, mainRenderPass vulkan.RenderPass, props ChainProps, attachments []vulkan.ImageView) vulkan.Framebuffer {
 	info := &vulkan.FramebufferCreateInfo{
 		SType:           vulkan.StructureTypeFramebufferCreateInfo,
 		RenderPass:      mainRenderPass,
-		AttachmentCount: 1,
-		PAttachments: []vulkan.ImageView{
-			view,
-		},
-		Width:  props.BufferSize.Width,
-		Height: props.BufferSize.Height,
-		Layers: 1,
+		AttachmentCount: uint32(len(attachments)),
+		PAttachments:    attachments,
+		Width:           props.BufferSize.Width,
+		Height:          props.BufferSize.Height,
+		Layers:          1,
 	}
 
 	var buffer vulkan.Framebuffer
@@ -13,7 +13,9 @@ type ChainProps struct {
 	ImageColorSpace vulkan.ColorSpace
 	BufferSize      vulkan.Extent2D
 	PresentMode     vulkan.PresentMode
+	ImageUsage      vulkan.ImageUsageFlags
 	BuffersCount    uint32
+	Headless        bool
 }
 
 func newProps(width, height uint32, pd *physical.Device, mobileFriendly bool) ChainProps {
@@ -25,10 +27,43 @@ func newProps(width, height uint32, pd *physical.Device, mobileFriendly bool) Ch
 		ImageColorSpace: richColorFormat.ColorSpace,
 		BufferSize:      gpuProps.ChooseSwapExtent(width, height),
 		PresentMode:     gpuProps.BestPresentMode(mobileFriendly),
+		ImageUsage:      imageUsage(gpuProps.Capabilities().SupportedUsageFlags),
 		BuffersCount:    gpuProps.ConcurrentBuffersCount(),
 	}
 }
 
+func newHeadlessProps(width, height uint32, pd *physical.Device) ChainProps {
+	gpuProps := pd.PrimaryGPU().SurfaceProps
+	richColorFormat := gpuProps.RichColorSpaceFormat()
+
+	return ChainProps{
+		ImageFormat:     richColorFormat.Format,
+		ImageColorSpace: richColorFormat.ColorSpace,
+		BufferSize:      gpuProps.ChooseSwapExtent(width, height),
+		ImageUsage:      vulkan.ImageUsageFlags(vulkan.ImageUsageColorAttachmentBit | vulkan.ImageUsageTransferSrcBit),
+		BuffersCount:    gpuProps.ConcurrentBuffersCount(),
+		Headless:        true,
+	}
+}
+
+// imageUsage will add transfer src usage to chain images, when
+// surface support it, so rendered frames can be read back to host
+func imageUsage(supported vulkan.ImageUsageFlags) vulkan.ImageUsageFlags {
+	usage := vulkan.ImageUsageFlags(vulkan.ImageUsageColorAttachmentBit)
+
+	if supported&vulkan.ImageUsageFlags(vulkan.ImageUsageTransferSrcBit) != 0 {
+		usage |= vulkan.ImageUsageFlags(vulkan.ImageUsageTransferSrcBit)
+	}
+
+	return usage
+}
+
+// IsReadable is true, when chain images content
+// can be copied to host memory
+func (p *ChainProps) IsReadable() bool {
+	return p.ImageUsage&vulkan.ImageUsageFlags(vulkan.ImageUsageTransferSrcBit) != 0
+}
+
 func (p *ChainProps) String() string {
 	return fmt.Sprintf("format=%s, colorSpace=%s, buffersCount=%s, bufferSize=%s, presentMode=%s",
 		p.formatString(),
@@ -60,6 +95,10 @@ func (p *ChainProps) bufferSizeString() string {
 }
 
 func (p *ChainProps) presentModeString() string {
+	if p.Headless {
+		return "none"
+	}
+
 	if name, ok := propsPresentModes[p.PresentMode]; ok {
 		return name
 	}
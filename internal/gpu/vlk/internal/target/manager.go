@@ -0,0 +1,245 @@
+package target
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/logical"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/must"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/renderpass"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/texture"
+	"github.com/go-glx/vgl/shared/vlkext"
+)
+
+// MaxID is max render target ID, zero ID is
+// reserved for main window surface
+const MaxID = math.MaxUint8
+
+type (
+	ID uint8
+
+	// Target is off-screen color image with framebuffer
+	// image is registered in texture manager, so it can be
+	// sampled as any other texture
+	Target struct {
+		id          ID
+		texture     *texture.Texture
+		framebuffer vulkan.Framebuffer
+	}
+
+	Manager struct {
+		logger   vlkext.Logger
+		ld       *logical.Device
+		textures *texture.Manager
+		pass     *renderpass.Pass
+
+		targets map[ID]*Target
+	}
+)
+
+func NewManager(
+	logger vlkext.Logger,
+	ld *logical.Device,
+	textures *texture.Manager,
+	pass *renderpass.Pass,
+) *Manager {
+	return &Manager{
+		logger:   logger,
+		ld:       ld,
+		textures: textures,
+		pass:     pass,
+
+		targets: make(map[ID]*Target),
+	}
+}
+
+func (m *Manager) Free() {
+	for id := range m.targets {
+		m.Delete(id)
+	}
+
+	m.logger.Debug("freed: render targets")
+}
+
+// RenderPass used for rendering into all targets
+func (m *Manager) RenderPass() *renderpass.Pass {
+	return m.pass
+}
+
+// Create will allocate new render target with first free ID
+// When all IDs is used (or image cannot be created), function
+// will return zero ID, that not valid target
+func (m *Manager) Create(width, height uint32) ID {
+	id, found := m.freeID()
+	if !found {
+		m.logger.Error(fmt.Sprintf("failed create render target: max targets count %d is reached", MaxID))
+		return 0
+	}
+
+	texID := m.textures.CreateAttachment(width, height)
+	if texID == 0 {
+		return 0
+	}
+
+	tex, _ := m.textures.Texture(texID)
+	m.targets[id] = &Target{
+		id:          id,
+		texture:     tex,
+		framebuffer: createFrameBuffer(m.ld, m.pass.Ref(), tex),
+	}
+
+	m.logger.Debug(fmt.Sprintf("render target %d (%dx%d) created", id, width, height))
+	return id
+}
+
+// Delete will free target framebuffer and image. Target should
+// not be used by GPU at this moment
+func (m *Manager) Delete(id ID) {
+	target, exist := m.targets[id]
+	if !exist {
+		m.logger.Error(fmt.Sprintf("failed delete render target: target %d not exist", id))
+		return
+	}
+
+	vulkan.DestroyFramebuffer(m.ld.Ref(), target.framebuffer, nil)
+	m.textures.Delete(target.texture.ID())
+	delete(m.targets, id)
+
+	m.logger.Debug(fmt.Sprintf("render target %d deleted", id))
+}
+
+// Target return target by ID, or false when target
+// not exist (or already deleted)
+func (m *Manager) Target(id ID) (*Target, bool) {
+	target, exist := m.targets[id]
+	return target, exist
+}
+
+// IsTargetTexture check that texture is image of some render target
+func (m *Manager) IsTargetTexture(id texture.ID) bool {
+	for _, target := range m.targets {
+		if target.texture.ID() == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Begin will start render pass into target. When clear is true,
+// all previous target content will be erased before drawing
+func (m *Manager) Begin(cb vulkan.CommandBuffer, target *Target, clear bool) {
+	vulkan.CmdBeginRenderPass(cb, &vulkan.RenderPassBeginInfo{
+		SType:       vulkan.StructureTypeRenderPassBeginInfo,
+		RenderPass:  m.pass.Ref(),
+		Framebuffer: target.framebuffer,
+		RenderArea:  target.Scissor(),
+	}, vulkan.SubpassContentsInline)
+
+	if !clear {
+		return
+	}
+
+	vulkan.CmdClearAttachments(cb, 1, []vulkan.ClearAttachment{
+		{
+			AspectMask:      vulkan.ImageAspectFlags(vulkan.ImageAspectColorBit),
+			ColorAttachment: 0,
+			ClearValue:      vulkan.NewClearValue([]float32{0, 0, 0, 0}),
+		},
+	}, 1, []vulkan.ClearRect{
+		{
+			Rect:           target.Scissor(),
+			BaseArrayLayer: 0,
+			LayerCount:     1,
+		},
+	})
+}
+
+// End will finish current target render pass
+func (m *Manager) End(cb vulkan.CommandBuffer) {
+	vulkan.CmdEndRenderPass(cb)
+}
+
+func (m *Manager) freeID() (ID, bool) {
+	for id := 1; id <= MaxID; id++ {
+		if _, used := m.targets[ID(id)]; !used {
+			return ID(id), true
+		}
+	}
+
+	return 0, false
+}
+
+func (t *Target) ID() ID {
+	return t.id
+}
+
+func (t *Target) TextureID() texture.ID {
+	return t.texture.ID()
+}
+
+func (t *Target) Image() vulkan.Image {
+	return t.texture.Image()
+}
+
+func (t *Target) Format() vulkan.Format {
+	return t.texture.Format()
+}
+
+// ImageLayout is layout of target image outside of render pass
+func (t *Target) ImageLayout() vulkan.ImageLayout {
+	return vulkan.ImageLayoutShaderReadOnlyOptimal
+}
+
+func (t *Target) Width() uint32 {
+	return t.texture.Width()
+}
+
+func (t *Target) Height() uint32 {
+	return t.texture.Height()
+}
+
+func (t *Target) Viewport() vulkan.Viewport {
+	return vulkan.Viewport{
+		X:        0,
+		Y:        0,
+		Width:    float32(t.Width()),
+		Height:   float32(t.Height()),
+		MinDepth: 0.0,
+		MaxDepth: 1.0,
+	}
+}
+
+func (t *Target) Scissor() vulkan.Rect2D {
+	return vulkan.Rect2D{
+		Offset: vulkan.Offset2D{
+			X: 0,
+			Y: 0,
+		},
+		Extent: vulkan.Extent2D{
+			Width:  t.Width(),
+			Height: t.Height(),
+		},
+	}
+}
+
+func createFrameBuffer(ld *logical.Device, pass vulkan.RenderPass, tex *texture.Texture) vulkan.Framebuffer {
+	info := &vulkan.FramebufferCreateInfo{
+		SType:           vulkan.StructureTypeFramebufferCreateInfo,
+		RenderPass:      pass,
+		AttachmentCount: 1,
+		PAttachments: []vulkan.ImageView{
+			tex.View(),
+		},
+		Width:  tex.Width(),
+		Height: tex.Height(),
+		Layers: 1,
+	}
+
+	var buffer vulkan.Framebuffer
+	must.Work(vulkan.CreateFramebuffer(ld.Ref(), info, nil, &buffer))
+
+	return buffer
+}
@@ -0,0 +1,211 @@
+package texture
+
+import (
+	"fmt"
+
+	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/alloc"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/def"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/dscptr"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/logical"
+	"github.com/go-glx/vgl/shared/vlkext"
+)
+
+// Format of all textures pixel data
+// data is 4 bytes per pixel, with not-premultiplied alpha
+const Format = vulkan.FormatR8g8b8a8Unorm
+
+type (
+	ID uint32
+
+	Texture struct {
+		id    ID
+		image alloc.ImageAllocation
+		set   vulkan.DescriptorSet
+	}
+
+	Manager struct {
+		logger      vlkext.Logger
+		ld          *logical.Device
+		heap        *alloc.Heap
+		descriptors *dscptr.Manager
+
+		samplers [filtersCount]vulkan.Sampler
+		lastID   ID
+		textures map[ID]*Texture
+		freeSets []vulkan.DescriptorSet // sets of deleted textures, ready for reuse
+	}
+)
+
+func NewManager(
+	logger vlkext.Logger,
+	ld *logical.Device,
+	heap *alloc.Heap,
+	descriptors *dscptr.Manager,
+) *Manager {
+	m := &Manager{
+		logger:      logger,
+		ld:          ld,
+		heap:        heap,
+		descriptors: descriptors,
+
+		lastID:   0,
+		textures: make(map[ID]*Texture),
+		freeSets: make([]vulkan.DescriptorSet, 0),
+	}
+
+	for filter := range m.samplers {
+		m.samplers[filter] = createSampler(ld, Filter(filter))
+	}
+
+	return m
+}
+
+func (m *Manager) Free() {
+	for _, tex := range m.textures {
+		m.heap.FreeImage(tex.image)
+	}
+
+	for _, sampler := range m.samplers {
+		vulkan.DestroySampler(m.ld.Ref(), sampler, nil)
+	}
+	m.logger.Debug("freed: textures")
+}
+
+// Create will upload pixels to GPU memory and return ID of created texture
+// pixels should be in Format, and have exactly width*height*4 bytes.
+// Texture is sampled in shaders with specified filter
+//
+// When textures limit is reached, function will return zero ID, that
+// not valid for drawing
+func (m *Manager) Create(pixels []byte, width, height uint32, filter Filter) ID {
+	if uint32(len(pixels)) != width*height*4 {
+		m.logger.Error(fmt.Sprintf("failed create texture %dx%d: expected %d bytes of pixels data, got %d",
+			width,
+			height,
+			width*height*4,
+			len(pixels),
+		))
+		return 0
+	}
+
+	if !m.hasFreeSlot() {
+		return 0
+	}
+
+	return m.register(m.heap.WriteImage(pixels, width, height, Format, alloc.StorageTargetImmutable), filter)
+}
+
+// CreateAttachment will create empty (transparent) texture, that
+// can be used as render pass color attachment (render target)
+// and sampled in shaders as any other texture
+//
+// When textures limit is reached, function will return zero ID
+func (m *Manager) CreateAttachment(width, height uint32) ID {
+	if width == 0 || height == 0 {
+		m.logger.Error(fmt.Sprintf("failed create attachment texture %dx%d: size should be positive",
+			width,
+			height,
+		))
+		return 0
+	}
+
+	if !m.hasFreeSlot() {
+		return 0
+	}
+
+	return m.register(m.heap.CreateAttachmentImage(width, height, Format), FilterLinear)
+}
+
+// Delete will free texture image. Texture should not be used
+// by GPU at this moment
+func (m *Manager) Delete(id ID) {
+	tex, exist := m.textures[id]
+	if !exist {
+		m.logger.Error(fmt.Sprintf("failed delete texture: texture %d not exist", id))
+		return
+	}
+
+	m.heap.FreeImage(tex.image)
+	m.freeSets = append(m.freeSets, tex.set)
+	delete(m.textures, id)
+
+	m.logger.Debug(fmt.Sprintf("texture %d deleted", id))
+}
+
+// Texture return texture by ID, or false when texture
+// not exist (or already deleted)
+func (m *Manager) Texture(id ID) (*Texture, bool) {
+	tex, exist := m.textures[id]
+	return tex, exist
+}
+
+func (m *Manager) hasFreeSlot() bool {
+	if len(m.textures) < def.MaxStaticSets {
+		return true
+	}
+
+	m.logger.Error(fmt.Sprintf("failed create texture: max textures count %d is reached",
+		def.MaxStaticSets,
+	))
+	return false
+}
+
+func (m *Manager) register(image alloc.ImageAllocation, filter Filter) ID {
+	if filter >= filtersCount {
+		filter = FilterLinear
+	}
+
+	set := m.acquireSet()
+	m.descriptors.UpdateImageSet(set, dscptr.LayoutIndexTexture, 0, image.View, m.samplers[filter])
+
+	m.lastID++
+	m.textures[m.lastID] = &Texture{
+		id:    m.lastID,
+		image: image,
+		set:   set,
+	}
+
+	m.logger.Debug(fmt.Sprintf("texture %d (%dx%d) created", m.lastID, image.Width, image.Height))
+	return m.lastID
+}
+
+func (m *Manager) acquireSet() vulkan.DescriptorSet {
+	if len(m.freeSets) == 0 {
+		return m.descriptors.AllocateStaticSet(dscptr.LayoutIndexTexture)
+	}
+
+	set := m.freeSets[len(m.freeSets)-1]
+	m.freeSets = m.freeSets[:len(m.freeSets)-1]
+
+	return set
+}
+
+func (t *Texture) ID() ID {
+	return t.id
+}
+
+func (t *Texture) DescriptorSet() vulkan.DescriptorSet {
+	return t.set
+}
+
+func (t *Texture) View() vulkan.ImageView {
+	return t.image.View
+}
+
+func (t *Texture) Image() vulkan.Image {
+	return t.image.Image
+}
+
+func (t *Texture) Format() vulkan.Format {
+	return t.image.Format
+}
+
+func (t *Texture) Width() uint32 {
+	return t.image.Width
+}
+
+func (t *Texture) Height() uint32 {
+	return t.image.Height
+}
@@ -0,0 +1,55 @@
+package texture
+
+import (
+	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/logical"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/must"
+)
+
+const (
+	FilterLinear  Filter = iota // smooth interpolation between texels
+	FilterNearest               // nearest texel, pixel-perfect (pixel art, bitmap fonts)
+
+	filtersCount
+)
+
+// Filter define how texels are sampled, when
+// texture is scaled (not drawn in its pixel size)
+type Filter uint8
+
+func samplerFilter(filter Filter) (vulkan.Filter, vulkan.SamplerMipmapMode) {
+	if filter == FilterNearest {
+		return vulkan.FilterNearest, vulkan.SamplerMipmapModeNearest
+	}
+
+	return vulkan.FilterLinear, vulkan.SamplerMipmapModeLinear
+}
+
+func createSampler(ld *logical.Device, filter Filter) vulkan.Sampler {
+	vkFilter, mipmapMode := samplerFilter(filter)
+
+	info := &vulkan.SamplerCreateInfo{
+		SType:                   vulkan.StructureTypeSamplerCreateInfo,
+		MagFilter:               vkFilter,
+		MinFilter:               vkFilter,
+		MipmapMode:              mipmapMode,
+		AddressModeU:            vulkan.SamplerAddressModeClampToEdge,
+		AddressModeV:            vulkan.SamplerAddressModeClampToEdge,
+		AddressModeW:            vulkan.SamplerAddressModeClampToEdge,
+		MipLodBias:              0,
+		AnisotropyEnable:        vulkan.False, // todo: optional, when GPU support samplerAnisotropy feature
+		MaxAnisotropy:           1,
+		CompareEnable:           vulkan.False,
+		CompareOp:               vulkan.CompareOpAlways,
+		MinLod:                  0,
+		MaxLod:                  0,
+		BorderColor:             vulkan.BorderColorIntOpaqueBlack,
+		UnnormalizedCoordinates: vulkan.False,
+	}
+
+	var sampler vulkan.Sampler
+	must.Work(vulkan.CreateSampler(ld.Ref(), info, nil, &sampler))
+
+	return sampler
+}
@@ -0,0 +1,25 @@
+package vkconv
+
+import (
+	"fmt"
+
+	"github.com/vulkan-go/vulkan"
+)
+
+// ToRGBA will convert raw image pixels (4 bytes per pixel) from
+// format components order into RGBA order. Conversion is done in
+// place, error is returned for not supported formats
+func ToRGBA(format vulkan.Format, pixels []byte) error {
+	switch format {
+	case vulkan.FormatR8g8b8a8Unorm, vulkan.FormatR8g8b8a8Srgb:
+		return nil
+	case vulkan.FormatB8g8r8a8Unorm, vulkan.FormatB8g8r8a8Srgb:
+		for i := 0; i+3 < len(pixels); i += 4 {
+			pixels[i], pixels[i+2] = pixels[i+2], pixels[i]
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("pixels conversion from format %d to RGBA is not supported", format)
+	}
+}
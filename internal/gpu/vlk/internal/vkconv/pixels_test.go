@@ -0,0 +1,58 @@
+package vkconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vulkan-go/vulkan"
+)
+
+func TestToRGBA(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  vulkan.Format
+		in      []byte
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:   "rgba not changed",
+			format: vulkan.FormatR8g8b8a8Unorm,
+			in:     []byte{1, 2, 3, 4, 5, 6, 7, 8},
+			want:   []byte{1, 2, 3, 4, 5, 6, 7, 8},
+		},
+		{
+			name:   "bgra swizzled",
+			format: vulkan.FormatB8g8r8a8Unorm,
+			in:     []byte{1, 2, 3, 4, 5, 6, 7, 8},
+			want:   []byte{3, 2, 1, 4, 7, 6, 5, 8},
+		},
+		{
+			name:   "empty",
+			format: vulkan.FormatB8g8r8a8Srgb,
+			in:     []byte{},
+			want:   []byte{},
+		},
+		{
+			name:    "not supported",
+			format:  vulkan.FormatR16g16b16a16Sfloat,
+			in:      []byte{1, 2, 3, 4},
+			want:    []byte{1, 2, 3, 4},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ToRGBA(tt.format, tt.in)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, tt.in)
+		})
+	}
+}
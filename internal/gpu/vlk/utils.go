@@ -1,5 +1,15 @@
 package vlk
 
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/vulkan-go/vulkan"
+)
+
+// mat4 is column-major 4x4 matrix, same memory layout as GLSL mat4
+type mat4 = [16]float32
+
 func min(a, b uint32) uint32 {
 	if a < b {
 		return a
@@ -7,3 +17,64 @@ func min(a, b uint32) uint32 {
 
 	return b
 }
+
+func mat4Identity() mat4 {
+	return mat4{
+		1, 0, 0, 0,
+		0, 1, 0, 0,
+		0, 0, 1, 0,
+		0, 0, 0, 1,
+	}
+}
+
+// mat4Ortho2d is projection from surface pixel space
+// (0,0 is top-left corner) into vulkan clip space [-1 .. +1]
+func mat4Ortho2d(width, height float32) mat4 {
+	if width <= 0 || height <= 0 {
+		return mat4Identity()
+	}
+
+	return mat4{
+		2 / width, 0, 0, 0,
+		0, 2 / height, 0, 0,
+		0, 0, 1, 0,
+		-1, -1, 0, 1,
+	}
+}
+
+func mat4Data(m mat4) []byte {
+	buff := make([]byte, len(m)*4)
+
+	for ind, value := range m {
+		binary.LittleEndian.PutUint32(buff[ind*4:], math.Float32bits(value))
+	}
+
+	return buff
+}
+
+// intersectRect2D returns common area of two rects, or
+// zero size rect, when rects is not intersected
+func intersectRect2D(a, b vulkan.Rect2D) vulkan.Rect2D {
+	left, top := int64(a.Offset.X), int64(a.Offset.Y)
+	right, bottom := left+int64(a.Extent.Width), top+int64(a.Extent.Height)
+
+	if x := int64(b.Offset.X); x > left {
+		left = x
+	}
+	if y := int64(b.Offset.Y); y > top {
+		top = y
+	}
+	if x := int64(b.Offset.X) + int64(b.Extent.Width); x < right {
+		right = x
+	}
+	if y := int64(b.Offset.Y) + int64(b.Extent.Height); y < bottom {
+		bottom = y
+	}
+
+	rect := vulkan.Rect2D{Offset: vulkan.Offset2D{X: int32(left), Y: int32(top)}}
+	if right > left && bottom > top {
+		rect.Extent = vulkan.Extent2D{Width: uint32(right - left), Height: uint32(bottom - top)}
+	}
+
+	return rect
+}
@@ -7,11 +7,9 @@ import (
 	"github.com/vulkan-go/vulkan"
 
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/alloc"
-	"github.com/go-glx/vgl/internal/gpu/vlk/internal/pipeline"
 	"github.com/go-glx/vgl/shared/metrics"
 )
 
-// todo: api for view,projection mat4
 // todo: multisampling "vulkan.SampleCount4Bit" can be used for test "ErrorDeviceLost"
 // todo: set swapChain images count=1 (index out of range [1] with length 1) imageID > 0 can be created
 // todo: panic after 10-15 sec in circle demo at (result := vulkan.CreateGraphicsPipelines() in internal/pipeline/factory.go)
@@ -26,7 +24,6 @@ import (
 //         that is invalid because bound VkDescriptorSet 0x310000000031[] was destroyed or updated.
 // todo: new metrics api for timing groups
 // todo: panic when vertex buffer bigger than 16mb
-// todo: pipeline cache is broken on screen resolution change (currently commented, need fix)
 // todo: broken caches/binds on resolution change in draw_pipe.go
 
 type (
@@ -45,8 +42,9 @@ type VLK struct {
 	statsUpdateFPSQueued bool
 
 	// surfaces
-	surfaceInd   surfaceID       // 0 - default (Screen, window); 1-255 reserved for user needs
-	surfacesSize [255][2]float32 // width, height for each surface
+	surfaceInd   surfaceID                   // 0 - default (Screen, window); 1-255 off-screen render targets
+	surfacesSize [maxSurfaces + 1][2]float32 // width, height for each surface
+	surfacesView [maxSurfaces + 1]mat4       // view (camera) matrix for each surface
 
 	// drawing
 	drawAvailable        bool
@@ -54,7 +52,6 @@ type VLK struct {
 	drawContext          *drawContext
 	drawExecution        drawCtxFn
 	drawShaderIndexesMap map[string]alloc.Allocation // shaderID -> allocation (is pointer to index buffer for this shader)'
-	drawPipelineCache    map[string]pipeline.Info
 }
 
 func newVLK(cont *Container) *VLK {
@@ -69,17 +66,21 @@ func newVLK(cont *Container) *VLK {
 
 		// surface
 		surfaceInd:   surfaceIdMainWindow,
-		surfacesSize: [255][2]float32{},
+		surfacesSize: [maxSurfaces + 1][2]float32{},
 
 		// drawing
 		drawShaderIndexesMap: make(map[string]alloc.Allocation),
-		drawPipelineCache:    make(map[string]pipeline.Info), // todo: is useful / fast?
 	}
 
 	// set default screen size
 	wWidth, wHeight := cont.wm.GetFramebufferSize()
 	vlk.surfacesSize[surfaceIdMainWindow] = [2]float32{float32(wWidth), float32(wHeight)}
 
+	// set default view (without camera) for all surfaces
+	for ind := range vlk.surfacesView {
+		vlk.surfacesView[ind] = mat4Identity()
+	}
+
 	// build drawing pipeline
 	vlk.initDrawingPipeline()
 
@@ -1,15 +1,43 @@
 package vlk
 
 import (
+	"fmt"
+
 	"github.com/vulkan-go/vulkan"
 
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/pipeline"
 	"github.com/go-glx/vgl/internal/gpu/vlk/internal/shader"
 )
 
+type BlendMode = pipeline.BlendMode
+
+const (
+	BlendModeAlpha         = pipeline.BlendModeAlpha
+	BlendModeAdditive      = pipeline.BlendModeAdditive
+	BlendModeMultiply      = pipeline.BlendModeMultiply
+	BlendModeScreen        = pipeline.BlendModeScreen
+	BlendModePremultiplied = pipeline.BlendModePremultiplied
+	BlendModeReplace       = pipeline.BlendModeReplace
+)
+
+type MaskMode = pipeline.MaskMode
+
+const (
+	MaskModeNone    = pipeline.MaskModeNone
+	MaskModeWrite   = pipeline.MaskModeWrite
+	MaskModeClear   = pipeline.MaskModeClear
+	MaskModeInside  = pipeline.MaskModeInside
+	MaskModeOutside = pipeline.MaskModeOutside
+)
+
 type (
 	DrawOptions struct {
 		PolygonMode vulkan.PolygonMode
-		// BlendMode vulkan.BlendOp // todo
+		Texture     TextureID // 0 - without texture
+		BlendMode   BlendMode
+		Clip        vulkan.Rect2D // scissor rect in surface pixels (used only with HasClip)
+		HasClip     bool          // drawing is clipped by Clip rect (otherwise by whole surface)
+		MaskMode    MaskMode      // stencil mask writing/testing
 	}
 )
 
@@ -18,5 +46,33 @@ func (vlk *VLK) Draw(name string, opts DrawOptions, data shader.InstanceData) {
 		return
 	}
 
+	if opts.Texture != 0 {
+		if _, exist := vlk.cont.textureManager().Texture(opts.Texture); !exist {
+			vlk.cont.logger.Error(fmt.Sprintf("failed draw '%s': texture %d not exist", name, opts.Texture))
+			return
+		}
+
+		if vlk.surfaceInd != surfaceIdMainWindow && opts.Texture == vlk.SurfaceTexture(uint8(vlk.surfaceInd)) {
+			vlk.cont.logger.Error(fmt.Sprintf("failed draw '%s': surface %d cannot be drawn into itself", name, vlk.surfaceInd))
+			return
+		}
+	}
+
+	if opts.MaskMode != MaskModeNone && !vlk.isMaskAvailable() {
+		if opts.MaskMode.IsWrite() {
+			// nothing to write into
+			return
+		}
+
+		opts.MaskMode = MaskModeNone
+	}
+
 	vlk.drawQueue(vlk.cont.shaderManager().ShaderByID(name), opts, data)
 }
+
+// isMaskAvailable is true, when current surface has stencil
+// attachment. Only main window surface has it (and only
+// when GPU support stencil formats)
+func (vlk *VLK) isMaskAvailable() bool {
+	return vlk.surfaceInd == surfaceIdMainWindow && vlk.cont.renderPassMain().HasStencil()
+}
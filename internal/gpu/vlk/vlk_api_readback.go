@@ -0,0 +1,123 @@
+package vlk
+
+import (
+	"fmt"
+
+	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/target"
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/vkconv"
+)
+
+// ReadSurface will copy surface pixels in rect (x, y, width, height)
+// from GPU memory, and return them as RGBA bytes (4 bytes per pixel,
+// row by row) with real rect size. Zero width or height means
+// whole surface
+//
+// For main surface (0) image of last submitted frame is used, for
+// off-screen surfaces - current surface content. Window images is
+// presented after frame end and cannot be read directly, so they
+// is copied inside frame (before present), only when this copy is
+// enabled by config.WithWindowCapture. Copy of frame is available
+// after its FrameEnd, so call between FrameStart and FrameEnd
+// return previous frame (one frame latency)
+//
+// This is slow function, that wait until GPU finish all current work
+func (vlk *VLK) ReadSurface(id uint8, x, y, width, height uint32) ([]byte, uint32, uint32, error) {
+	if !vlk.isReady {
+		return nil, 0, 0, fmt.Errorf("failed read surface %d: renderer is not ready", id)
+	}
+
+	vlk.GPUWait()
+
+	source, err := vlk.surfaceReadSource(id)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed read surface %d: %w", id, err)
+	}
+
+	if width == 0 || height == 0 {
+		x, y = 0, 0
+		width, height = source.size.Width, source.size.Height
+	}
+
+	if x+width > source.size.Width || y+height > source.size.Height {
+		return nil, 0, 0, fmt.Errorf("failed read surface %d: rect (%d,%d %dx%d) out of surface size (%dx%d)",
+			id, x, y, width, height, source.size.Width, source.size.Height,
+		)
+	}
+
+	pixels := source.read(vulkan.Rect2D{
+		Offset: vulkan.Offset2D{X: int32(x), Y: int32(y)},
+		Extent: vulkan.Extent2D{Width: width, Height: height},
+	})
+
+	err = vkconv.ToRGBA(source.format, pixels)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed read surface %d: %w", id, err)
+	}
+
+	return pixels, width, height, nil
+}
+
+type surfaceReadSource struct {
+	format vulkan.Format
+	size   vulkan.Extent2D
+	read   func(rect vulkan.Rect2D) []byte
+}
+
+func (vlk *VLK) surfaceReadSource(id uint8) (surfaceReadSource, error) {
+	heap := vlk.cont.allocHeap()
+
+	if id != surfaceIdMainWindow {
+		rt, exist := vlk.cont.renderTargets().Target(target.ID(id))
+		if !exist {
+			return surfaceReadSource{}, fmt.Errorf("surface not exist")
+		}
+
+		return surfaceReadSource{
+			format: rt.Format(),
+			size:   rt.Scissor().Extent,
+			read: func(rect vulkan.Rect2D) []byte {
+				return heap.ReadImage(rt.Image(), rt.ImageLayout(), rect)
+			},
+		}, nil
+	}
+
+	chain := vlk.cont.swapChain()
+	props := chain.Props()
+	if !props.IsReadable() {
+		return surfaceReadSource{}, fmt.Errorf("window surface not support reading images")
+	}
+
+	frames := vlk.cont.frameManager()
+	imageID, rendered := frames.LastImageID()
+	if !rendered {
+		return surfaceReadSource{}, fmt.Errorf("nothing is rendered yet")
+	}
+
+	if chain.IsHeadless() {
+		// headless images is owned by renderer, and
+		// not used by GPU after GPUWait
+		return surfaceReadSource{
+			format: props.ImageFormat,
+			size:   props.BufferSize,
+			read: func(rect vulkan.Rect2D) []byte {
+				return heap.ReadImage(chain.Image(int(imageID)), chain.ImageLayout(), rect)
+			},
+		}, nil
+	}
+
+	if !vlk.cont.cfg.IsWindowCapture() {
+		return surfaceReadSource{}, fmt.Errorf("window surface capture is disabled (see config.WithWindowCapture)")
+	}
+
+	if !frames.HasCapture() {
+		return surfaceReadSource{}, fmt.Errorf("nothing is captured yet")
+	}
+
+	return surfaceReadSource{
+		format: props.ImageFormat,
+		size:   props.BufferSize,
+		read:   chain.ReadCapture,
+	}, nil
+}
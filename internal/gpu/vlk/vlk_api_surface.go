@@ -0,0 +1,81 @@
+package vlk
+
+import (
+	"fmt"
+
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/target"
+)
+
+// CreateSurface will create off-screen surface (render target) with
+// specified size in pixels, and return its ID (1-255). Zero ID is
+// returned, when surface cannot be created
+//
+// Surface image is also a texture (see SurfaceTexture), so it can
+// be drawn on other surfaces in same frame, after drawing into it
+func (vlk *VLK) CreateSurface(width, height uint32) uint8 {
+	id := vlk.cont.renderTargets().Create(width, height)
+	if id == 0 {
+		return 0
+	}
+
+	vlk.surfacesSize[id] = [2]float32{float32(width), float32(height)}
+	vlk.surfacesView[id] = mat4Identity()
+
+	return uint8(id)
+}
+
+// FreeSurface will release off-screen surface GPU memory
+// this will wait for GPU idle, because surface can be used
+// in current rendering frames
+//
+// When surface is current, renderer will switch to main surface (0)
+func (vlk *VLK) FreeSurface(id uint8) {
+	if id == surfaceIdMainWindow {
+		vlk.cont.logger.Error("failed free surface: main surface (0) cannot be deleted")
+		return
+	}
+
+	vlk.GPUWait()
+	vlk.cont.renderTargets().Delete(target.ID(id))
+
+	vlk.surfacesSize[id] = [2]float32{}
+	vlk.surfacesView[id] = mat4Identity()
+
+	if vlk.surfaceInd == surfaceID(id) {
+		_ = vlk.SetSurface(surfaceIdMainWindow)
+	}
+}
+
+// SetSurface will switch current surface, all next drawings
+// will be rendered into it. 0 - main surface (window), 1-255
+// off-screen surfaces created with CreateSurface
+func (vlk *VLK) SetSurface(id uint8) error {
+	if id != surfaceIdMainWindow {
+		if _, exist := vlk.cont.renderTargets().Target(target.ID(id)); !exist {
+			return fmt.Errorf("failed set surface: surface %d not exist", id)
+		}
+	}
+
+	vlk.surfaceInd = surfaceID(id)
+
+	if vlk.drawContext == nil || len(vlk.drawContext.surfaces) == 0 {
+		// surface will be created on first draw
+		return nil
+	}
+
+	// break baking, next drawings go to new surface
+	vlk.setSurface(vlk.surfaceInd)
+	return nil
+}
+
+// SurfaceTexture returns ID of texture, that contains off-screen
+// surface image. Zero ID is returned for main surface (or not
+// existing surface)
+func (vlk *VLK) SurfaceTexture(id uint8) TextureID {
+	rt, exist := vlk.cont.renderTargets().Target(target.ID(id))
+	if !exist {
+		return 0
+	}
+
+	return rt.TextureID()
+}
@@ -0,0 +1,47 @@
+package vlk
+
+import (
+	"fmt"
+
+	"github.com/go-glx/vgl/internal/gpu/vlk/internal/texture"
+)
+
+type (
+	TextureID     = texture.ID
+	TextureFilter = texture.Filter
+)
+
+const (
+	TextureFilterLinear  = texture.FilterLinear
+	TextureFilterNearest = texture.FilterNearest
+)
+
+// CreateTexture will upload RGBA (not premultiplied) pixels to GPU
+// and return texture ID, that can be used in DrawOptions.Texture
+// zero ID is returned, when texture cannot be created
+func (vlk *VLK) CreateTexture(pixels []byte, width, height uint32, filter TextureFilter) TextureID {
+	return vlk.cont.textureManager().Create(pixels, width, height, filter)
+}
+
+// FreeTexture will release texture GPU memory
+// this will wait for GPU idle, because texture can be used
+// in current rendering frames
+func (vlk *VLK) FreeTexture(id TextureID) {
+	if vlk.cont.renderTargets().IsTargetTexture(id) {
+		vlk.cont.logger.Error(fmt.Sprintf("failed free texture %d: texture is owned by surface, use FreeSurface instead", id))
+		return
+	}
+
+	vlk.GPUWait()
+	vlk.cont.textureManager().Delete(id)
+}
+
+// TextureSize return texture size in pixels
+func (vlk *VLK) TextureSize(id TextureID) (width uint32, height uint32) {
+	tex, exist := vlk.cont.textureManager().Texture(id)
+	if !exist {
+		return 0, 0
+	}
+
+	return tex.Width(), tex.Height()
+}
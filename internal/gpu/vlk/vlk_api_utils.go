@@ -43,6 +43,12 @@ func (vlk *VLK) FrameStart() {
 		vlk.statsUpdateFPSQueued = false
 	}
 
+	// swap chain can be recreated, so capture
+	// should be enabled again for new chain
+	if vlk.cont.cfg.IsWindowCapture() {
+		vlk.cont.swapChain().EnableCapture()
+	}
+
 	// start command buffers
 	vlk.drawFrameCtx, vlk.drawAvailable = vlk.cont.frameManager().FrameBegin(vlk.drawFrameCtx)
 }
@@ -93,3 +99,41 @@ func (vlk *VLK) collectMemoryGroupStats(in alloc.GroupedStats, out *metrics.Usag
 	out.PagesCount += int(in.TotalPages)
 	out.AreasCount += int(in.TotalAreas)
 }
+
+// CountCulled will count one instance, that not drawn
+// because it not visible on current surface
+func (vlk *VLK) CountCulled() {
+	vlk.stats.Culled++
+}
+
+// SetSurfaceView will set view (camera) matrix for current surface
+// matrix is column-major mat4, that transform surface pixel space
+// with camera. All next drawings on this surface will use this view
+func (vlk *VLK) SetSurfaceView(view [16]float32) {
+	vlk.surfacesView[vlk.surfaceInd] = view
+
+	if vlk.drawContext == nil || len(vlk.drawContext.surfaces) == 0 {
+		// surface will be created with this view on first draw
+		return
+	}
+
+	// apply new view to queue (this can break baking)
+	vlk.setSurface(vlk.surfaceInd)
+}
+
+// CurrentSurface returns current surface ID
+// 0 - default (screen), 1-255 user surfaces
+func (vlk *VLK) CurrentSurface() uint8 {
+	return uint8(vlk.surfaceInd)
+}
+
+// PointSizeRange returns min and max point size in pixels (gl_PointSize),
+// supported by GPU. Range is [1 .. 1], when GPU not support large points
+func (vlk *VLK) PointSizeRange() (min float32, max float32) {
+	gpu := vlk.cont.physicalDevice().PrimaryGPU()
+	if gpu.Features.LargePoints != vulkan.True {
+		return 1, 1
+	}
+
+	return gpu.Props.Limits.PointSizeRange[0], gpu.Props.Limits.PointSizeRange[1]
+}
@@ -1,8 +1,17 @@
 #version 450
+#extension GL_GOOGLE_include_directive : require
+
+#include "mask.glsl"
 
 // fix float calculations
 const float epsilon = 0.0001;
 
+// mode flags
+const uint modeEllipse = 1;     // radius/thickness in pixels (holeRadius and smoothness is ignored)
+const uint modeArc = 2;         // cut by arc angles
+const uint modeRoundEnds = 4;   // arc ends is rounded
+const uint modePie = 8;         // arc is pie sector (thickness is ignored)
+
 // -----------------
 
 layout(set=0, binding = 1) uniform UniformBufferObject {
@@ -19,6 +28,20 @@ struct Circle {
     // 0.005 - default value (minimum smooth)
     // 0.0   - without smooth
     float smoothness;
+
+    // 0 - legacy circle (holeRadius + smoothness)
+    // other - mode flags
+    float mode;
+
+    // ring thickness in pixels (0 - filled)
+    float thickness;
+
+    // x,y radius in pixels
+    vec2 radius;
+
+    // x - arc middle angle (radians, clock-wise from +x axis)
+    // y - half of arc angle
+    vec2 arc;
 };
 
 layout(set=1, binding = 0) readonly buffer Props {
@@ -35,9 +58,81 @@ layout(location = 0) out vec4 outColor;
 
 // -----------------
 
+// approximated signed distance to ellipse edge (pixels)
+float sdEllipse(vec2 p, vec2 r) {
+    float f = length(p / r) - 1;
+    vec2 grad = p / (r * r) / max(length(p / r), epsilon);
+
+    return f / max(length(grad), epsilon);
+}
+
+// signed distance to symmetric (around +x axis) wedge with half angle
+float sdWedge(vec2 p, float halfAngle) {
+    p.y = abs(p.y);
+
+    vec2 edge = vec2(cos(halfAngle), sin(halfAngle));
+    float dist = length(p - edge * max(dot(p, edge), 0));
+    bool inside = (p.x * edge.y - p.y * edge.x) > 0;
+
+    return inside ? -dist : dist;
+}
+
+// point on ring center line in arc end direction
+vec2 arcEnd(vec2 dir, vec2 r, float thickness) {
+    float edge = 1 / max(length(dir / r), epsilon);
+    return dir * (edge - thickness / 2);
+}
+
+float sdCircle(Circle c, vec2 p) {
+    float dist = sdEllipse(p, c.radius);
+    uint mode = uint(c.mode);
+
+    if ((mode & modeArc) == 0) {
+        return (c.thickness > 0) ? max(dist, -(dist + c.thickness)) : dist;
+    }
+
+    // rotate, so arc is symmetric around +x axis
+    vec2 dir = vec2(cos(c.arc.x), sin(c.arc.x));
+    vec2 q = vec2(dot(p, dir), p.x * -dir.y + p.y * dir.x);
+    float wedge = sdWedge(q, c.arc.y);
+
+    if ((mode & modePie) != 0 || c.thickness <= 0) {
+        return max(dist, wedge);
+    }
+
+    dist = max(max(dist, -(dist + c.thickness)), wedge);
+
+    if ((mode & modeRoundEnds) != 0) {
+        vec2 start = arcEnd(vec2(cos(c.arc.x - c.arc.y), sin(c.arc.x - c.arc.y)), c.radius, c.thickness);
+        vec2 end = arcEnd(vec2(cos(c.arc.x + c.arc.y), sin(c.arc.x + c.arc.y)), c.radius, c.thickness);
+
+        dist = min(dist, length(p - start) - c.thickness / 2);
+        dist = min(dist, length(p - end) - c.thickness / 2);
+    }
+
+    return dist;
+}
+
 void main() {
     Circle c = props.circles[instanceID];
 
+    if (c.mode > 0) {
+        // UV is [-1 .. 1] in quad, that is ellipse bounding box
+        vec2 p = UV * c.radius;
+
+        // size of one screen pixel in circle space
+        float px = max(length(fwidth(p)) * 0.7071, epsilon);
+        float alpha = clamp(0.5 - sdCircle(c, p) / px, 0, 1);
+
+        if (alpha < epsilon) {
+            discard;
+        }
+
+        outColor = vec4(fragColor.rgb, fragColor.a * alpha);
+        maskDiscard(outColor.a);
+        return;
+    }
+
     float len = length(UV);
     float thickness = 1 - c.holeRadius;
 
@@ -48,5 +143,6 @@ void main() {
     circle *= smoothstep(1 - thickness - c.smoothness - epsilon, 1 - thickness, len);
 
     outColor = vec4(fragColor.rgb, fragColor.a * circle);
+    maskDiscard(outColor.a);
 }
 
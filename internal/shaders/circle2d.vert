@@ -20,8 +20,11 @@ vec2 uvs[4] = vec2[](
 );
 
 void main() {
-    gl_Position = ubo.view * ubo.proj * vec4(inPosition, 0.0, 1.0);
+    gl_Position = ubo.proj * ubo.view * vec4(inPosition, 0.0, 1.0);
     outColor = inColor;
-    outInstanceID = gl_InstanceIndex;
+    // all instances is drawn with single instance draw, where index
+    // buffer offset every instance by its vertex count, so instance
+    // ID is derived from vertex index (gl_InstanceIndex is always 0)
+    outInstanceID = gl_VertexIndex / 4;
     UV = uvs[gl_VertexIndex % 4];
 }
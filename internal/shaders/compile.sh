@@ -2,3 +2,15 @@ glslc univ2d.vert -o univ2d.vert.spv
 glslc univ2d.frag -o univ2d.frag.spv
 glslc circle2d.vert -o circle2d.vert.spv
 glslc circle2d.frag -o circle2d.frag.spv
+glslc texture2d.vert -o texture2d.vert.spv
+glslc texture2d.frag -o texture2d.frag.spv
+glslc msdf2d.vert -o msdf2d.vert.spv
+glslc msdf2d.frag -o msdf2d.frag.spv
+glslc sdf2d.vert -o sdf2d.vert.spv
+glslc sdf2d.frag -o sdf2d.frag.spv
+glslc points2d.vert -o points2d.vert.spv
+glslc points2d.frag -o points2d.frag.spv
+glslc line2d.vert -o line2d.vert.spv
+glslc line2d.frag -o line2d.frag.spv
+glslc gradient2d.vert -o gradient2d.vert.spv
+glslc gradient2d.frag -o gradient2d.frag.spv
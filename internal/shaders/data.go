@@ -14,6 +14,36 @@ var (
 	circle2dCodeVert []byte
 	//go:embed circle2d.frag.spv
 	circle2dCodeFrag []byte
+
+	//go:embed texture2d.vert.spv
+	texture2dCodeVert []byte
+	//go:embed texture2d.frag.spv
+	texture2dCodeFrag []byte
+
+	//go:embed msdf2d.vert.spv
+	msdf2dCodeVert []byte
+	//go:embed msdf2d.frag.spv
+	msdf2dCodeFrag []byte
+
+	//go:embed sdf2d.vert.spv
+	sdf2dCodeVert []byte
+	//go:embed sdf2d.frag.spv
+	sdf2dCodeFrag []byte
+
+	//go:embed points2d.vert.spv
+	points2dCodeVert []byte
+	//go:embed points2d.frag.spv
+	points2dCodeFrag []byte
+
+	//go:embed line2d.vert.spv
+	line2dCodeVert []byte
+	//go:embed line2d.frag.spv
+	line2dCodeFrag []byte
+
+	//go:embed gradient2d.vert.spv
+	gradient2dCodeVert []byte
+	//go:embed gradient2d.frag.spv
+	gradient2dCodeFrag []byte
 )
 
 func Universal2DVertSpv() []byte {
@@ -31,3 +61,51 @@ func Circle2DVertSpv() []byte {
 func Circle2DFragSpv() []byte {
 	return circle2dCodeFrag
 }
+
+func Texture2DVertSpv() []byte {
+	return texture2dCodeVert
+}
+
+func Texture2DFragSpv() []byte {
+	return texture2dCodeFrag
+}
+
+func MSDF2DVertSpv() []byte {
+	return msdf2dCodeVert
+}
+
+func MSDF2DFragSpv() []byte {
+	return msdf2dCodeFrag
+}
+
+func SDF2DVertSpv() []byte {
+	return sdf2dCodeVert
+}
+
+func SDF2DFragSpv() []byte {
+	return sdf2dCodeFrag
+}
+
+func Points2DVertSpv() []byte {
+	return points2dCodeVert
+}
+
+func Points2DFragSpv() []byte {
+	return points2dCodeFrag
+}
+
+func Line2DVertSpv() []byte {
+	return line2dCodeVert
+}
+
+func Line2DFragSpv() []byte {
+	return line2dCodeFrag
+}
+
+func Gradient2DVertSpv() []byte {
+	return gradient2dCodeVert
+}
+
+func Gradient2DFragSpv() []byte {
+	return gradient2dCodeFrag
+}
@@ -0,0 +1,55 @@
+// multi-stop gradient, shared by shaders with gradient fill
+// (include with GL_GOOGLE_include_directive)
+
+const float gradientNone = 0;
+const float gradientLinear = 1;
+const float gradientRadial = 2;
+const float gradientConic = 3;
+
+const int gradientMaxStops = 8;
+
+struct Gradient {
+    // x - gradient type (0 - none)
+    // y - stops count
+    vec4 params;
+
+    // linear - xy start point, zw end point
+    // radial - xy center, z radius
+    // conic  - xy center, z start angle (radians)
+    vec4 geometry;
+
+    // stop offsets [0 .. 1] (4 in every vec4)
+    vec4 offsets[gradientMaxStops / 4];
+
+    // stop colors
+    vec4 colors[gradientMaxStops];
+};
+
+// gradientColor returns gradient color at world position
+vec4 gradientColor(Gradient g, vec2 pos) {
+    float t = 0;
+
+    if (g.params.x == gradientLinear) {
+        vec2 dir = g.geometry.zw - g.geometry.xy;
+        t = dot(pos - g.geometry.xy, dir) / max(dot(dir, dir), 0.0001);
+    } else if (g.params.x == gradientRadial) {
+        t = length(pos - g.geometry.xy) / max(g.geometry.z, 0.0001);
+    } else if (g.params.x == gradientConic) {
+        vec2 v = pos - g.geometry.xy;
+        t = fract((atan(v.y, v.x) - g.geometry.z) / 6.28318531);
+    }
+
+    t = clamp(t, 0, 1);
+
+    int count = min(int(g.params.y), gradientMaxStops);
+    vec4 color = g.colors[0];
+
+    for (int i = 1; i < count; i++) {
+        float from = g.offsets[(i - 1) / 4][(i - 1) % 4];
+        float to = g.offsets[i / 4][i % 4];
+
+        color = mix(color, g.colors[i], clamp((t - from) / max(to - from, 0.0001), 0, 1));
+    }
+
+    return color;
+}
@@ -0,0 +1,26 @@
+#version 450
+#extension GL_GOOGLE_include_directive : require
+
+#include "gradient.glsl"
+#include "mask.glsl"
+
+// -----------------
+
+layout(set=1, binding = 0) readonly buffer Props {
+    Gradient[] gradients;
+} props;
+
+// -----------------
+
+layout(location = 0) in vec4 fragColor;
+layout(location = 1) flat in uint instanceID;
+layout(location = 2) in vec2 fragWorld;
+
+layout(location = 0) out vec4 outColor;
+
+// -----------------
+
+void main() {
+    outColor = gradientColor(props.gradients[instanceID], fragWorld);
+    maskDiscard(outColor.a);
+}
@@ -0,0 +1,23 @@
+#version 450
+
+layout(set=0, binding = 0) uniform UniformBufferObject {
+    mat4 view;
+    mat4 proj;
+} ubo;
+
+layout(location = 0) in vec2 inPosition;
+layout(location = 1) in vec4 inColor;
+
+layout(location = 0) out vec4 outColor;
+layout(location = 1) out flat uint outInstanceID;
+layout(location = 2) out vec2 outWorld;
+
+void main() {
+    gl_Position = ubo.proj * ubo.view * vec4(inPosition, 0.0, 1.0);
+    outColor = inColor;
+    // all instances is drawn with single instance draw, where index
+    // buffer offset every instance by its vertex count, so instance
+    // ID is derived from vertex index (gl_InstanceIndex is always 0)
+    outInstanceID = gl_VertexIndex / 3;
+    outWorld = inPosition;
+}
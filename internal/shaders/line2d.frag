@@ -0,0 +1,67 @@
+#version 450
+#extension GL_GOOGLE_include_directive : require
+
+#include "mask.glsl"
+
+// fix float calculations
+const float epsilon = 0.0001;
+
+const float capButt = 0;
+const float capSquare = 1;
+const float capRound = 2;
+
+// -----------------
+
+struct Line {
+    // x - half of segment length
+    // y - half of line width
+    // z - cap type
+    vec4 params;
+};
+
+layout(set=1, binding = 0) readonly buffer Props {
+    Line[] lines;
+} props;
+
+// -----------------
+
+// local position relative to segment center (x along segment, in world pixels)
+layout(location = 0) in vec4 fragColor;
+layout(location = 1) flat in uint instanceID;
+layout(location = 2) in vec2 fragLocal;
+
+layout(location = 0) out vec4 outColor;
+
+// -----------------
+
+void main() {
+    Line l = props.lines[instanceID];
+
+    // size of one screen pixel in line space
+    float px = max(length(fwidth(fragLocal)) * 0.7071, epsilon);
+
+    // lines thinner than one pixel is drawn with one
+    // pixel width and proportionally smaller alpha
+    float halfWidth = max(l.params.y, px * 0.5);
+    float coverage = min(l.params.y * 2 / px, 1);
+
+    vec2 p = abs(fragLocal);
+    float dist;
+
+    if (l.params.z == capRound) {
+        dist = length(vec2(max(p.x - l.params.x, 0), p.y)) - halfWidth;
+    } else {
+        float halfLength = l.params.x + ((l.params.z == capSquare) ? halfWidth : 0);
+        vec2 q = p - vec2(halfLength, halfWidth);
+
+        dist = min(max(q.x, q.y), 0) + length(max(q, 0));
+    }
+
+    float alpha = clamp(0.5 - dist / px, 0, 1) * coverage;
+    if (alpha < epsilon) {
+        discard;
+    }
+
+    outColor = vec4(fragColor.rgb, fragColor.a * alpha);
+    maskDiscard(outColor.a);
+}
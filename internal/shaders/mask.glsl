@@ -0,0 +1,16 @@
+// stencil mask writing, shared by all 2d shaders
+// (include with GL_GOOGLE_include_directive)
+
+// true, when pipeline draws into stencil mask (see Render.BeginMask)
+// color is not written in this mode, only stencil
+layout(constant_id = 0) const bool maskWrite = false;
+
+// pixels with lower alpha is not written into mask, so
+// shape edges and texture alpha define mask shape
+const float maskAlphaThreshold = 0.5;
+
+void maskDiscard(float alpha) {
+    if (maskWrite && alpha < maskAlphaThreshold) {
+        discard;
+    }
+}
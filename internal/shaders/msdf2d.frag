@@ -0,0 +1,82 @@
+#version 450
+#extension GL_GOOGLE_include_directive : require
+
+#include "mask.glsl"
+
+// fix float calculations
+const float epsilon = 0.0001;
+
+// -----------------
+
+layout(set=3, binding = 0) uniform sampler2D texSampler;
+
+struct Glyph {
+    vec4 outlineColor;
+    vec4 shadowColor;
+
+    // x - distance range in atlas pixels
+    // y - outline width in screen pixels (0 = without outline)
+    // z - shadow softness in screen pixels
+    vec4 params;
+
+    // xy - shadow offset in screen pixels
+    vec4 shadowOffset;
+};
+
+layout(set=1, binding = 0) readonly buffer Props {
+    Glyph[] glyphs;
+} props;
+
+// -----------------
+
+layout(location = 0) in vec4 fragColor;
+layout(location = 1) flat in uint instanceID;
+layout(location = 2) in vec2 fragUV;
+
+layout(location = 0) out vec4 outColor;
+
+// -----------------
+
+float median(vec3 v) {
+    return max(min(v.r, v.g), min(max(v.r, v.g), v.b));
+}
+
+// straight alpha "over" composition
+vec4 over(vec4 top, vec4 bottom) {
+    float alpha = top.a + bottom.a * (1 - top.a);
+    vec3 color = (top.rgb * top.a + bottom.rgb * bottom.a * (1 - top.a)) / max(alpha, epsilon);
+
+    return vec4(color, alpha);
+}
+
+void main() {
+    Glyph g = props.glyphs[instanceID];
+
+    // how many screen pixels is in one distance range
+    vec2 unitRange = vec2(g.params.x) / vec2(textureSize(texSampler, 0));
+    vec2 screenTexSize = vec2(1.0) / fwidth(fragUV);
+    float screenPxRange = max(0.5 * dot(unitRange, screenTexSize), 1.0);
+
+    // shadow offset from screen to texture space
+    vec2 shadowUV = fragUV - (dFdx(fragUV) * g.shadowOffset.x + dFdy(fragUV) * g.shadowOffset.y);
+
+    // signed distance to glyph edge in screen pixels (>0 inside glyph)
+    float dist = screenPxRange * (median(texture(texSampler, fragUV).rgb) - 0.5);
+    float shadowDist = screenPxRange * (median(texture(texSampler, shadowUV).rgb) - 0.5);
+
+    vec4 color = vec4(fragColor.rgb, fragColor.a * clamp(dist + 0.5, 0, 1));
+
+    if (g.params.y > 0) {
+        float outline = clamp(dist + g.params.y + 0.5, 0, 1);
+        color = over(color, vec4(g.outlineColor.rgb, g.outlineColor.a * outline));
+    }
+
+    if (g.shadowColor.a > 0) {
+        float softness = max(g.params.z, 0.5);
+        float shadow = smoothstep(-softness, softness, shadowDist + g.params.y);
+        color = over(color, vec4(g.shadowColor.rgb, g.shadowColor.a * shadow));
+    }
+
+    outColor = color;
+    maskDiscard(outColor.a);
+}
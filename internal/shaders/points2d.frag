@@ -0,0 +1,33 @@
+#version 450
+#extension GL_GOOGLE_include_directive : require
+
+#include "mask.glsl"
+
+const float shapeCircle = 1;
+
+layout(location = 0) in vec4 fragColor;
+layout(location = 1) flat in vec2 fragParams;
+
+layout(location = 0) out vec4 outColor;
+
+void main() {
+    if (fragParams.x <= 0) {
+        discard;
+    }
+
+    float alpha = 1;
+
+    if (fragParams.y == shapeCircle) {
+        // distance from point edge in screen pixels (>0 inside)
+        float radius = fragParams.x / 2;
+        float dist = radius - length(gl_PointCoord * 2 - 1) * radius;
+
+        alpha = clamp(dist + 0.5, 0, 1);
+        if (alpha <= 0) {
+            discard;
+        }
+    }
+
+    outColor = vec4(fragColor.rgb, fragColor.a * alpha);
+    maskDiscard(outColor.a);
+}
@@ -0,0 +1,27 @@
+#version 450
+
+layout(set=0, binding = 0) uniform UniformBufferObject {
+    mat4 view;
+    mat4 proj;
+} ubo;
+
+layout(location = 0) in vec2 inPosition;
+layout(location = 1) in vec4 inColor;
+
+// x - size in world pixels (0 - padding point, not visible)
+// y - shape (0 - square, 1 - circle)
+layout(location = 2) in vec2 inParams;
+
+layout(location = 0) out vec4 outColor;
+layout(location = 1) out flat vec2 outParams;
+
+void main() {
+    // camera zoom is scale of view matrix
+    float zoom = length(ubo.view[0].xy);
+    float size = inParams.x * zoom;
+
+    gl_Position = ubo.proj * ubo.view * vec4(inPosition, 0.0, 1.0);
+    gl_PointSize = max(size, 1);
+    outColor = inColor;
+    outParams = vec2(size, inParams.y);
+}
@@ -0,0 +1,144 @@
+#version 450
+#extension GL_GOOGLE_include_directive : require
+
+#include "gradient.glsl"
+#include "mask.glsl"
+
+// fix float calculations
+const float epsilon = 0.0001;
+const float pi = 3.14159265;
+
+const float shapeRoundedRect = 0;
+const float shapeCapsule = 1;
+const float shapeNgon = 2;
+
+// -----------------
+
+struct Shape {
+    vec4 outlineColor;
+    vec4 shadowColor;
+
+    // rounded rect - x,y half size
+    // capsule      - x half length (from center to cap center)
+    // ngon         - x circumradius, y sides count
+    vec4 size;
+
+    // rounded rect - corner radius (tl, tr, br, bl)
+    // capsule      - x radius
+    // ngon         - x corner radius
+    vec4 radius;
+
+    // x - shape type
+    // y - outline width (inside of shape, 0 = without outline)
+    // z - shadow blur
+    vec4 params;
+
+    // xy - shadow offset in shape space
+    vec4 shadowOffset;
+
+    // fill gradient (used instead of vertex color, when type is not none)
+    Gradient gradient;
+};
+
+layout(set=1, binding = 0) readonly buffer Props {
+    Shape[] shapes;
+} props;
+
+// -----------------
+
+// all positions/distances in shape local space (world pixels, without rotation)
+layout(location = 0) in vec4 fragColor;
+layout(location = 1) flat in uint instanceID;
+layout(location = 2) in vec2 fragLocal;
+layout(location = 3) in vec2 fragWorld;
+
+layout(location = 0) out vec4 outColor;
+
+// -----------------
+
+float sdRoundedRect(vec2 p, vec2 halfSize, vec4 radius) {
+    vec2 side = (p.x > 0) ? radius.yz : radius.xw; // right (tr, br) : left (tl, bl)
+    float r = (p.y > 0) ? side.y : side.x;         // bottom : top
+
+    vec2 q = abs(p) - halfSize + r;
+    return min(max(q.x, q.y), 0) + length(max(q, 0)) - r;
+}
+
+float sdCapsule(vec2 p, float halfLength, float radius) {
+    p.x = max(abs(p.x) - halfLength, 0);
+    return length(p) - radius;
+}
+
+// first vertex is pointing up
+float sdNgon(vec2 p, float radius, float sides, float rounding) {
+    float an = pi / sides;
+    vec2 acs = vec2(cos(an), sin(an));
+
+    // polygon with rounded corners is smaller polygon + radius
+    float r = radius - rounding / acs.x;
+
+    float bn = mod(atan(p.x, -p.y), 2 * an) - an;
+    p = length(p) * vec2(cos(bn), abs(sin(bn)));
+    p -= r * acs;
+    p.y += clamp(-p.y, 0, r * acs.y);
+
+    return length(p) * sign(p.x) - rounding;
+}
+
+float sdShape(Shape s, vec2 p) {
+    if (s.params.x == shapeCapsule) {
+        return sdCapsule(p, s.size.x, s.radius.x);
+    }
+
+    if (s.params.x == shapeNgon) {
+        return sdNgon(p, s.size.x, s.size.y, s.radius.x);
+    }
+
+    return sdRoundedRect(p, s.size.xy, s.radius);
+}
+
+// straight alpha "over" composition
+vec4 over(vec4 top, vec4 bottom) {
+    float alpha = top.a + bottom.a * (1 - top.a);
+    vec3 color = (top.rgb * top.a + bottom.rgb * bottom.a * (1 - top.a)) / max(alpha, epsilon);
+
+    return vec4(color, alpha);
+}
+
+void main() {
+    Shape s = props.shapes[instanceID];
+
+    // size of one screen pixel in shape space
+    float px = max(length(fwidth(fragLocal)) * 0.7071, epsilon);
+
+    float dist = sdShape(s, fragLocal);
+    float body = clamp(0.5 - dist / px, 0, 1);
+
+    vec4 fill = fragColor;
+    if (s.gradient.params.x != gradientNone) {
+        fill = gradientColor(s.gradient, fragWorld);
+    }
+
+    vec4 color = fill;
+    if (s.params.y > 0) {
+        float inner = clamp(0.5 - (dist + s.params.y) / px, 0, 1);
+        color = mix(s.outlineColor, fill, inner);
+    }
+
+    color.a *= body;
+
+    if (s.shadowColor.a > 0) {
+        float blur = max(s.params.z, px * 0.5);
+        float shadowDist = sdShape(s, fragLocal - s.shadowOffset.xy);
+        float shadow = 1 - smoothstep(-blur, blur, shadowDist);
+
+        color = over(color, vec4(s.shadowColor.rgb, s.shadowColor.a * shadow));
+    }
+
+    if (color.a < epsilon) {
+        discard;
+    }
+
+    outColor = color;
+    maskDiscard(outColor.a);
+}
@@ -0,0 +1,20 @@
+#version 450
+#extension GL_GOOGLE_include_directive : require
+
+#include "mask.glsl"
+
+layout(set=3, binding = 0) uniform sampler2D texSampler;
+
+// -----------------
+
+layout(location = 0) in vec4 fragColor;
+layout(location = 1) in vec2 fragUV;
+
+layout(location = 0) out vec4 outColor;
+
+// -----------------
+
+void main() {
+    outColor = texture(texSampler, fragUV) * fragColor;
+    maskDiscard(outColor.a);
+}
@@ -0,0 +1,20 @@
+#version 450
+
+layout(set=0, binding = 0) uniform UniformBufferObject {
+    mat4 view;
+    mat4 proj;
+} ubo;
+
+layout(location = 0) in vec2 inPosition;
+layout(location = 1) in vec4 inColor;
+layout(location = 2) in vec2 inUV;
+layout(location = 3) in float inAlpha;
+
+layout(location = 0) out vec4 outColor;
+layout(location = 1) out vec2 outUV;
+
+void main() {
+    gl_Position = ubo.proj * ubo.view * vec4(inPosition, 0.0, 1.0);
+    outColor = vec4(inColor.rgb, inColor.a * inAlpha);
+    outUV = inUV;
+}
@@ -1,8 +1,12 @@
 #version 450
+#extension GL_GOOGLE_include_directive : require
+
+#include "mask.glsl"
 
 layout(location = 0) in vec4 fragColor;
 layout(location = 0) out vec4 outColor;
 
 void main() {
     outColor = fragColor;
+    maskDiscard(outColor.a);
 }
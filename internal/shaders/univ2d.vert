@@ -11,7 +11,7 @@ layout(location = 1) in vec4 inColor;
 layout(location = 0) out vec4 outColor;
 
 void main() {
-    gl_Position = ubo.view * ubo.proj * vec4(inPosition, 0.0, 1.0);
+    gl_Position = ubo.proj * ubo.view * vec4(inPosition, 0.0, 1.0);
     gl_PointSize = 1;
     outColor = inColor;
 }
@@ -0,0 +1,43 @@
+package vgl
+
+import (
+	"math"
+
+	"github.com/go-glx/glx"
+)
+
+// lineQuad returns clock-wise quad (tl, tr, br, bl) around line segment, that
+// cover line width, caps and anti-aliasing margin. Local positions is relative
+// to segment center, where x axis is directed from a to b
+func lineQuad(a, b glx.Vec2, halfWidth float32, cap LineCap, margin float32) (pos [4]glx.Vec2, local [4]glx.Vec2) {
+	delta := b.Sub(a)
+	length := float32(math.Hypot(float64(delta.X), float64(delta.Y)))
+
+	dir := glx.Vec2{X: 1}
+	if length > 0 {
+		dir = delta.Scale(1 / length)
+	}
+
+	normal := glx.Vec2{X: -dir.Y, Y: dir.X}
+
+	// sub-pixel lines is drawn with one pixel width in shader
+	extentY := float32(math.Max(float64(halfWidth), float64(margin/2))) + margin
+	extentX := length/2 + margin
+	if cap != LineCapButt {
+		extentX += extentY - margin
+	}
+
+	local = [4]glx.Vec2{
+		{X: -extentX, Y: -extentY}, // tl
+		{X: +extentX, Y: -extentY}, // tr
+		{X: +extentX, Y: +extentY}, // br
+		{X: -extentX, Y: +extentY}, // bl
+	}
+
+	center := a.Add(b).Scale(0.5)
+	for i, point := range local {
+		pos[i] = center.Add(dir.Scale(point.X)).Add(normal.Scale(point.Y))
+	}
+
+	return pos, local
+}
@@ -0,0 +1,43 @@
+package vgl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-glx/glx"
+)
+
+func TestLineQuad(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, b      glx.Vec2
+		halfWidth float32
+		cap       LineCap
+		tl, br    glx.Vec2 // world
+	}{
+		{name: "horizontal butt", a: glx.Vec2{X: 10, Y: 10}, b: glx.Vec2{X: 30, Y: 10}, halfWidth: 2, cap: LineCapButt, tl: glx.Vec2{X: 9, Y: 7}, br: glx.Vec2{X: 31, Y: 13}},
+		{name: "horizontal round", a: glx.Vec2{X: 10, Y: 10}, b: glx.Vec2{X: 30, Y: 10}, halfWidth: 2, cap: LineCapRound, tl: glx.Vec2{X: 7, Y: 7}, br: glx.Vec2{X: 33, Y: 13}},
+		{name: "vertical down", a: glx.Vec2{X: 10, Y: 10}, b: glx.Vec2{X: 10, Y: 30}, halfWidth: 2, cap: LineCapButt, tl: glx.Vec2{X: 13, Y: 9}, br: glx.Vec2{X: 7, Y: 31}},
+		{name: "sub-pixel width", a: glx.Vec2{X: 10, Y: 10}, b: glx.Vec2{X: 30, Y: 10}, halfWidth: 0.1, cap: LineCapButt, tl: glx.Vec2{X: 9, Y: 8.5}, br: glx.Vec2{X: 31, Y: 11.5}},
+		{name: "zero length", a: glx.Vec2{X: 10, Y: 10}, b: glx.Vec2{X: 10, Y: 10}, halfWidth: 2, cap: LineCapSquare, tl: glx.Vec2{X: 7, Y: 7}, br: glx.Vec2{X: 13, Y: 13}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pos, local := lineQuad(tt.a, tt.b, tt.halfWidth, tt.cap, 1)
+
+			assert.InDelta(t, tt.tl.X, pos[0].X, 0.0001)
+			assert.InDelta(t, tt.tl.Y, pos[0].Y, 0.0001)
+			assert.InDelta(t, tt.br.X, pos[2].X, 0.0001)
+			assert.InDelta(t, tt.br.Y, pos[2].Y, 0.0001)
+
+			// local space is symmetric around segment center
+			assert.InDelta(t, -local[0].X, local[2].X, 0.0001)
+			assert.InDelta(t, -local[0].Y, local[2].Y, 0.0001)
+
+			cross := (pos[1].X-pos[0].X)*(pos[2].Y-pos[0].Y) - (pos[1].Y-pos[0].Y)*(pos[2].X-pos[0].X)
+			assert.Greater(t, cross, float32(0))
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package vgl
+
+import (
+	"math"
+
+	"github.com/go-glx/glx"
+)
+
+const (
+	FillRuleNonZero FillRule = iota // point is inside, when path winding around it is not zero
+	FillRuleEvenOdd                 // point is inside, when ray from it cross path odd count of times
+)
+
+const (
+	path2dDefaultTolerance = 0.25 // px
+	path2dMaxCurveSegments = 1024
+)
+
+const (
+	path2dCmdMove path2dCmdKind = iota
+	path2dCmdLine
+	path2dCmdQuad
+	path2dCmdCubic
+	path2dCmdArc
+	path2dCmdClose
+)
+
+type (
+	// FillRule define what parts of self-intersecting
+	// path (or path with sub paths) is filled
+	FillRule uint8
+
+	// Path2d is vector path builder, that can be drawn with Draw2dPath.
+	// Curves is flattened and tessellated on first draw and cached inside
+	// path, so static path can be drawn every frame without CPU overhead.
+	// Any path change will reset cache.
+	//
+	// All positions is in world pixels, angles in radians (clock-wise on screen)
+	Path2d struct {
+		commands []path2dCmd
+
+		fillCache   path2dCache
+		strokeCache path2dCache
+	}
+
+	path2dCmdKind uint8
+
+	path2dCmd struct {
+		kind   path2dCmdKind
+		points [3]glx.Vec2 // control points and end point (or center for arc)
+		radius float32
+		from   glx.Angle
+		to     glx.Angle
+	}
+
+	path2dContour struct {
+		points []glx.Vec2
+		closed bool
+	}
+)
+
+// NewPath2d create empty path
+func NewPath2d() *Path2d {
+	return &Path2d{}
+}
+
+// MoveTo start new sub path in pos
+func (p *Path2d) MoveTo(pos glx.Vec2) *Path2d {
+	return p.add(path2dCmd{kind: path2dCmdMove, points: [3]glx.Vec2{pos}})
+}
+
+// LineTo add straight line from current point to pos
+func (p *Path2d) LineTo(pos glx.Vec2) *Path2d {
+	return p.add(path2dCmd{kind: path2dCmdLine, points: [3]glx.Vec2{pos}})
+}
+
+// QuadTo add quadratic bezier curve from current point to pos
+func (p *Path2d) QuadTo(ctrl glx.Vec2, pos glx.Vec2) *Path2d {
+	return p.add(path2dCmd{kind: path2dCmdQuad, points: [3]glx.Vec2{ctrl, pos}})
+}
+
+// CubicTo add cubic bezier curve from current point to pos
+func (p *Path2d) CubicTo(ctrl1 glx.Vec2, ctrl2 glx.Vec2, pos glx.Vec2) *Path2d {
+	return p.add(path2dCmd{kind: path2dCmdCubic, points: [3]glx.Vec2{ctrl1, ctrl2, pos}})
+}
+
+// ArcTo add circle arc around center from angle to angle. When to < from,
+// arc goes counter-clock-wise. Current point will be connected with arc
+// start by straight line (or new sub path started from arc start)
+func (p *Path2d) ArcTo(center glx.Vec2, radius float32, from glx.Angle, to glx.Angle) *Path2d {
+	return p.add(path2dCmd{kind: path2dCmdArc, points: [3]glx.Vec2{center}, radius: radius, from: from, to: to})
+}
+
+// Close connect current point with start of current sub path
+func (p *Path2d) Close() *Path2d {
+	return p.add(path2dCmd{kind: path2dCmdClose})
+}
+
+// Reset remove all sub paths, path can be reused after that
+func (p *Path2d) Reset() *Path2d {
+	p.commands = p.commands[:0]
+	p.invalidate()
+
+	return p
+}
+
+// IsEmpty is true, when path has no drawing commands
+func (p *Path2d) IsEmpty() bool {
+	return len(p.commands) == 0
+}
+
+// Bounds return path bounding box (tl, br). Curves is bounded
+// by its control points, so box can be bigger than path itself
+func (p *Path2d) Bounds() (glx.Vec2, glx.Vec2) {
+	min := glx.Vec2{X: float32(math.Inf(1)), Y: float32(math.Inf(1))}
+	max := glx.Vec2{X: float32(math.Inf(-1)), Y: float32(math.Inf(-1))}
+
+	extend := func(v glx.Vec2) {
+		min.X, min.Y = float32(math.Min(float64(min.X), float64(v.X))), float32(math.Min(float64(min.Y), float64(v.Y)))
+		max.X, max.Y = float32(math.Max(float64(max.X), float64(v.X))), float32(math.Max(float64(max.Y), float64(v.Y)))
+	}
+
+	for _, cmd := range p.commands {
+		switch cmd.kind {
+		case path2dCmdMove, path2dCmdLine:
+			extend(cmd.points[0])
+		case path2dCmdQuad:
+			extend(cmd.points[0])
+			extend(cmd.points[1])
+		case path2dCmdCubic:
+			extend(cmd.points[0])
+			extend(cmd.points[1])
+			extend(cmd.points[2])
+		case path2dCmdArc:
+			extend(cmd.points[0].Add(glx.Vec2{X: -cmd.radius, Y: -cmd.radius}))
+			extend(cmd.points[0].Add(glx.Vec2{X: cmd.radius, Y: cmd.radius}))
+		}
+	}
+
+	if min.X > max.X {
+		return glx.Vec2{}, glx.Vec2{}
+	}
+
+	return min, max
+}
+
+func (p *Path2d) add(cmd path2dCmd) *Path2d {
+	p.commands = append(p.commands, cmd)
+	p.invalidate()
+
+	return p
+}
+
+func (p *Path2d) invalidate() {
+	p.fillCache.valid = false
+	p.strokeCache.valid = false
+}
+
+// flatten convert path into contours of straight lines, curves
+// approximated with max distance error = tolerance
+func (p *Path2d) flatten(tolerance float32) []path2dContour {
+	contours := make([]path2dContour, 0, 1)
+	current := path2dContour{}
+
+	finish := func(closed bool) {
+		if len(current.points) > 1 {
+			current.closed = closed
+			contours = append(contours, current)
+		}
+
+		start := glx.Vec2{}
+		if len(current.points) > 0 {
+			start = current.points[0]
+		}
+
+		current = path2dContour{}
+		if closed {
+			// next commands continue from closed sub path start
+			current.points = []glx.Vec2{start}
+		}
+	}
+
+	lineTo := func(pos glx.Vec2) {
+		if len(current.points) > 0 && current.points[len(current.points)-1] == pos {
+			return
+		}
+
+		current.points = append(current.points, pos)
+	}
+
+	last := func() glx.Vec2 {
+		if len(current.points) == 0 {
+			return glx.Vec2{}
+		}
+
+		return current.points[len(current.points)-1]
+	}
+
+	for _, cmd := range p.commands {
+		switch cmd.kind {
+		case path2dCmdMove:
+			finish(false)
+			current.points = append(current.points[:0], cmd.points[0])
+		case path2dCmdLine:
+			lineTo(cmd.points[0])
+		case path2dCmdQuad:
+			from := last()
+			for _, pos := range flattenQuad(from, cmd.points[0], cmd.points[1], tolerance) {
+				lineTo(pos)
+			}
+		case path2dCmdCubic:
+			from := last()
+			for _, pos := range flattenCubic(from, cmd.points[0], cmd.points[1], cmd.points[2], tolerance) {
+				lineTo(pos)
+			}
+		case path2dCmdArc:
+			for _, pos := range flattenArc(cmd.points[0], cmd.radius, cmd.from, cmd.to, tolerance) {
+				lineTo(pos)
+			}
+		case path2dCmdClose:
+			finish(true)
+		}
+	}
+
+	finish(false)
+	return contours
+}
+
+// flattenQuad return curve points after from, segments count
+// is calculated by Wang's formula
+func flattenQuad(from, ctrl, to glx.Vec2, tolerance float32) []glx.Vec2 {
+	dd := path2dLength(from.Sub(ctrl.Scale(2)).Add(to))
+	count := path2dSegments(math.Sqrt(dd / (4 * float64(tolerance))))
+
+	points := make([]glx.Vec2, 0, count)
+	for i := 1; i <= count; i++ {
+		t := float32(i) / float32(count)
+		mt := 1 - t
+
+		points = append(points, from.Scale(mt*mt).Add(ctrl.Scale(2*mt*t)).Add(to.Scale(t*t)))
+	}
+
+	return points
+}
+
+// flattenCubic return curve points after from, segments count
+// is calculated by Wang's formula
+func flattenCubic(from, ctrl1, ctrl2, to glx.Vec2, tolerance float32) []glx.Vec2 {
+	dd := math.Max(
+		path2dLength(from.Sub(ctrl1.Scale(2)).Add(ctrl2)),
+		path2dLength(ctrl1.Sub(ctrl2.Scale(2)).Add(to)),
+	)
+	count := path2dSegments(math.Sqrt(dd * 3 / (4 * float64(tolerance))))
+
+	points := make([]glx.Vec2, 0, count)
+	for i := 1; i <= count; i++ {
+		t := float32(i) / float32(count)
+		mt := 1 - t
+
+		points = append(points, from.Scale(mt*mt*mt).
+			Add(ctrl1.Scale(3*mt*mt*t)).
+			Add(ctrl2.Scale(3*mt*t*t)).
+			Add(to.Scale(t*t*t)),
+		)
+	}
+
+	return points
+}
+
+// flattenArc return all arc points, including start point
+func flattenArc(center glx.Vec2, radius float32, from, to glx.Angle, tolerance float32) []glx.Vec2 {
+	sweep := float64(to - from)
+	if sweep > math.Pi*2 {
+		sweep = math.Pi * 2
+	}
+	if sweep < -math.Pi*2 {
+		sweep = -math.Pi * 2
+	}
+
+	step := math.Pi / 2
+	if radius > tolerance {
+		step = 2 * math.Acos(1-float64(tolerance/radius))
+	}
+
+	count := path2dSegments(math.Abs(sweep) / step)
+
+	points := make([]glx.Vec2, 0, count+1)
+	for i := 0; i <= count; i++ {
+		angle := float64(from) + sweep*float64(i)/float64(count)
+		points = append(points, center.Add(glx.Vec2{
+			X: float32(math.Cos(angle)) * radius,
+			Y: float32(math.Sin(angle)) * radius,
+		}))
+	}
+
+	return points
+}
+
+func path2dSegments(count float64) int {
+	if math.IsNaN(count) || count < 1 {
+		return 1
+	}
+	if count > path2dMaxCurveSegments {
+		return path2dMaxCurveSegments
+	}
+
+	return int(math.Ceil(count))
+}
+
+func path2dLength(v glx.Vec2) float64 {
+	return math.Hypot(float64(v.X), float64(v.Y))
+}
@@ -0,0 +1,259 @@
+package vgl
+
+import (
+	"math"
+	"sort"
+
+	"github.com/go-glx/glx"
+)
+
+type (
+	path2dCache struct {
+		valid     bool
+		key       path2dCacheKey
+		dash      []float32
+		triangles [][3]glx.Vec2
+	}
+
+	path2dCacheKey struct {
+		tolerance  float32
+		fillRule   FillRule
+		width      float32
+		join       LineJoin
+		cap        LineCap
+		miterLimit float32
+		dashOffset float32
+	}
+
+	path2dEdge struct {
+		x0, y0  float64 // top point
+		x1, y1  float64 // bottom point
+		winding int
+	}
+)
+
+func (c *path2dCache) get(key path2dCacheKey, dash []float32) ([][3]glx.Vec2, bool) {
+	if !c.valid || c.key != key || len(c.dash) != len(dash) {
+		return nil, false
+	}
+
+	for i := range dash {
+		if c.dash[i] != dash[i] {
+			return nil, false
+		}
+	}
+
+	return c.triangles, true
+}
+
+func (c *path2dCache) set(key path2dCacheKey, dash []float32, triangles [][3]glx.Vec2) {
+	c.valid = true
+	c.key = key
+	c.dash = append(c.dash[:0], dash...)
+	c.triangles = triangles
+}
+
+// path2dTolerance quantize flattening tolerance to power of two,
+// so small camera zoom changes not reset path cache every frame
+func path2dTolerance(tolerance float32) float32 {
+	if tolerance <= 0 {
+		tolerance = path2dDefaultTolerance
+	}
+
+	return float32(math.Exp2(math.Floor(math.Log2(float64(tolerance)))))
+}
+
+// tessellateFill return cached (or new) triangles of path filled with rule
+func (p *Path2d) tessellateFill(tolerance float32, rule FillRule) [][3]glx.Vec2 {
+	key := path2dCacheKey{tolerance: path2dTolerance(tolerance), fillRule: rule}
+	if triangles, ok := p.fillCache.get(key, nil); ok {
+		return triangles
+	}
+
+	triangles := fillContours(p.flatten(key.tolerance), rule)
+	p.fillCache.set(key, nil, triangles)
+
+	return triangles
+}
+
+// tessellateStroke return cached (or new) triangles of path outline
+func (p *Path2d) tessellateStroke(tolerance float32, style strokeStyle) [][3]glx.Vec2 {
+	key := path2dCacheKey{
+		tolerance:  path2dTolerance(tolerance),
+		width:      style.width,
+		join:       style.join,
+		cap:        style.cap,
+		miterLimit: style.miterLimit,
+		dashOffset: style.dashOffset,
+	}
+	if triangles, ok := p.strokeCache.get(key, style.dash); ok {
+		return triangles
+	}
+
+	triangles := make([][3]glx.Vec2, 0)
+	for _, contour := range p.flatten(key.tolerance) {
+		contourStyle := style
+		contourStyle.closed = contour.closed
+
+		for _, tri := range strokePolyline(contourStyle, contour.points, make([]glx.Vec4, len(contour.points))) {
+			triangles = append(triangles, [3]glx.Vec2{tri[0].pos, tri[1].pos, tri[2].pos})
+		}
+	}
+
+	p.strokeCache.set(key, style.dash, triangles)
+	return triangles
+}
+
+// fillContours tessellate contours (all is implicitly closed) with
+// scanline: path is cut into horizontal bands in every vertex and edges
+// intersection, so inside every band edges not cross each other and
+// filled spans between them is trapezoids
+func fillContours(contours []path2dContour, rule FillRule) [][3]glx.Vec2 {
+	edges := make([]path2dEdge, 0)
+	for _, contour := range contours {
+		for i := range contour.points {
+			a := contour.points[i]
+			b := contour.points[(i+1)%len(contour.points)]
+
+			if a.Y == b.Y {
+				continue // horizontal edges not change winding
+			}
+
+			edge := path2dEdge{x0: float64(a.X), y0: float64(a.Y), x1: float64(b.X), y1: float64(b.Y), winding: 1}
+			if edge.y0 > edge.y1 {
+				edge.x0, edge.y0, edge.x1, edge.y1 = edge.x1, edge.y1, edge.x0, edge.y0
+				edge.winding = -1
+			}
+
+			edges = append(edges, edge)
+		}
+	}
+
+	if len(edges) < 2 {
+		return nil
+	}
+
+	ys := make([]float64, 0, len(edges)*2)
+	for i, a := range edges {
+		ys = append(ys, a.y0, a.y1)
+
+		for _, b := range edges[i+1:] {
+			if y, ok := a.intersectY(b); ok {
+				ys = append(ys, y)
+			}
+		}
+	}
+
+	sort.Float64s(ys)
+
+	type crossing struct {
+		x0, x1, xm float64
+		winding    int
+	}
+
+	triangles := make([][3]glx.Vec2, 0)
+	active := make([]crossing, 0)
+
+	for i := 0; i+1 < len(ys); i++ {
+		top, bottom := ys[i], ys[i+1]
+		if bottom-top < 1e-9 {
+			continue
+		}
+
+		mid := (top + bottom) / 2
+
+		active = active[:0]
+		for _, edge := range edges {
+			if edge.y0 <= top && edge.y1 >= bottom {
+				active = append(active, crossing{
+					x0:      edge.xAt(top),
+					x1:      edge.xAt(bottom),
+					xm:      edge.xAt(mid),
+					winding: edge.winding,
+				})
+			}
+		}
+
+		sort.SliceStable(active, func(a, b int) bool {
+			return active[a].xm < active[b].xm
+		})
+
+		winding := 0
+		left := 0
+
+		for ind, cross := range active {
+			wasInside := rule.inside(winding)
+			winding += cross.winding
+			isInside := rule.inside(winding)
+
+			if !wasInside && isInside {
+				left = ind
+				continue
+			}
+
+			if wasInside && !isInside {
+				l, r := active[left], cross
+
+				tl := glx.Vec2{X: float32(l.x0), Y: float32(top)}
+				tr := glx.Vec2{X: float32(r.x0), Y: float32(top)}
+				br := glx.Vec2{X: float32(r.x1), Y: float32(bottom)}
+				bl := glx.Vec2{X: float32(l.x1), Y: float32(bottom)}
+
+				triangles = path2dEmit(triangles, tl, tr, br)
+				triangles = path2dEmit(triangles, br, bl, tl)
+			}
+		}
+	}
+
+	return triangles
+}
+
+func (r FillRule) inside(winding int) bool {
+	if r == FillRuleEvenOdd {
+		return winding%2 != 0
+	}
+
+	return winding != 0
+}
+
+func (e path2dEdge) xAt(y float64) float64 {
+	return e.x0 + (e.x1-e.x0)*(y-e.y0)/(e.y1-e.y0)
+}
+
+// intersectY return y of proper intersection point of two edges
+func (e path2dEdge) intersectY(o path2dEdge) (float64, bool) {
+	if e.y1 <= o.y0 || o.y1 <= e.y0 {
+		return 0, false
+	}
+
+	dx1, dy1 := e.x1-e.x0, e.y1-e.y0
+	dx2, dy2 := o.x1-o.x0, o.y1-o.y0
+
+	denom := dx1*dy2 - dy1*dx2
+	if denom == 0 {
+		return 0, false // parallel
+	}
+
+	t := ((o.x0-e.x0)*dy2 - (o.y0-e.y0)*dx2) / denom
+	u := ((o.x0-e.x0)*dy1 - (o.y0-e.y0)*dx1) / denom
+	if t <= 0 || t >= 1 || u <= 0 || u >= 1 {
+		return 0, false
+	}
+
+	return e.y0 + t*dy1, true
+}
+
+// path2dEmit add triangle in screen clock-wise order, degenerate triangles are skipped
+func path2dEmit(triangles [][3]glx.Vec2, a, b, c glx.Vec2) [][3]glx.Vec2 {
+	ab, ac := b.Sub(a), c.Sub(a)
+	cross := ab.X*ac.Y - ab.Y*ac.X
+
+	if cross == 0 {
+		return triangles
+	}
+	if cross < 0 {
+		b, c = c, b
+	}
+
+	return append(triangles, [3]glx.Vec2{a, b, c})
+}
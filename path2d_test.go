@@ -0,0 +1,168 @@
+package vgl
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-glx/glx"
+)
+
+func TestPath2d_Flatten(t *testing.T) {
+	path := NewPath2d().
+		LineTo(glx.Vec2{X: 0, Y: 0}).
+		LineTo(glx.Vec2{X: 10, Y: 0}).
+		LineTo(glx.Vec2{X: 10, Y: 0}).
+		LineTo(glx.Vec2{X: 10, Y: 10}).
+		Close().
+		LineTo(glx.Vec2{X: -10, Y: 0}).
+		MoveTo(glx.Vec2{X: 50, Y: 50}).
+		MoveTo(glx.Vec2{X: 20, Y: 20}).
+		QuadTo(glx.Vec2{X: 30, Y: 40}, glx.Vec2{X: 40, Y: 20})
+
+	contours := path.flatten(0.25)
+	assert.Len(t, contours, 3)
+
+	// duplicate point removed
+	assert.Equal(t, []glx.Vec2{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 10}}, contours[0].points)
+	assert.True(t, contours[0].closed)
+
+	// continue from closed sub path start
+	assert.Equal(t, []glx.Vec2{{X: 0, Y: 0}, {X: -10, Y: 0}}, contours[1].points)
+	assert.False(t, contours[1].closed)
+
+	// empty MoveTo ignored, curve ends exactly in end point
+	curve := contours[2].points
+	assert.Equal(t, glx.Vec2{X: 20, Y: 20}, curve[0])
+	assert.Equal(t, glx.Vec2{X: 40, Y: 20}, curve[len(curve)-1])
+	assert.Greater(t, len(curve), 3)
+}
+
+func TestPath2d_FlattenTolerance(t *testing.T) {
+	from, ctrl1, ctrl2, to := glx.Vec2{X: 0, Y: 0}, glx.Vec2{X: 0, Y: 100}, glx.Vec2{X: 100, Y: 100}, glx.Vec2{X: 100, Y: 0}
+
+	coarse := flattenCubic(from, ctrl1, ctrl2, to, 4)
+	fine := flattenCubic(from, ctrl1, ctrl2, to, 0.25)
+	assert.Less(t, len(coarse), len(fine))
+	assert.Equal(t, to, fine[len(fine)-1])
+
+	// straight curve is single segment
+	assert.Len(t, flattenQuad(from, glx.Vec2{X: 50, Y: 0}, to, 0.25), 1)
+
+	// all arc points on circle, error between points is inside tolerance
+	arc := flattenArc(glx.Vec2{X: 0, Y: 0}, 50, 0, math.Pi, 0.25)
+	for i, point := range arc {
+		assert.InDelta(t, 50, path2dLength(point), 0.001)
+
+		if i > 0 {
+			mid := point.Add(arc[i-1]).Scale(0.5)
+			assert.LessOrEqual(t, 50-path2dLength(mid), 0.2501)
+		}
+	}
+
+	assert.InDelta(t, -50, arc[len(arc)-1].X, 0.001)
+}
+
+func TestPath2d_Fill(t *testing.T) {
+	square := func(path *Path2d, x, y, size float32, cw bool) *Path2d {
+		points := []glx.Vec2{{X: x, Y: y}, {X: x + size, Y: y}, {X: x + size, Y: y + size}, {X: x, Y: y + size}}
+		if !cw {
+			points[1], points[3] = points[3], points[1]
+		}
+
+		path.MoveTo(points[0])
+		for _, point := range points[1:] {
+			path.LineTo(point)
+		}
+
+		return path.Close()
+	}
+
+	tests := []struct {
+		name  string
+		path  *Path2d
+		rule  FillRule
+		area  float64
+		delta float64
+	}{
+		{name: "empty", path: NewPath2d(), area: 0},
+		{name: "line only", path: NewPath2d().MoveTo(glx.Vec2{}).LineTo(glx.Vec2{X: 10, Y: 10}), area: 0},
+		{name: "square", path: square(NewPath2d(), 0, 0, 10, true), area: 100},
+		{name: "open sub path is filled", path: NewPath2d().LineTo(glx.Vec2{}).LineTo(glx.Vec2{X: 10}).LineTo(glx.Vec2{X: 10, Y: 10}), area: 50},
+		{name: "same direction hole, non zero", path: square(square(NewPath2d(), 0, 0, 10, true), 2, 2, 6, true), rule: FillRuleNonZero, area: 100},
+		{name: "same direction hole, even odd", path: square(square(NewPath2d(), 0, 0, 10, true), 2, 2, 6, true), rule: FillRuleEvenOdd, area: 64},
+		{name: "reversed hole, non zero", path: square(square(NewPath2d(), 0, 0, 10, true), 2, 2, 6, false), rule: FillRuleNonZero, area: 64},
+		{name: "overlapped squares, non zero", path: square(square(NewPath2d(), 0, 0, 10, true), 5, 5, 10, true), rule: FillRuleNonZero, area: 175},
+		{name: "overlapped squares, even odd", path: square(square(NewPath2d(), 0, 0, 10, true), 5, 5, 10, true), rule: FillRuleEvenOdd, area: 150},
+		{
+			name: "self intersecting bow tie",
+			path: NewPath2d().
+				MoveTo(glx.Vec2{X: 0, Y: 0}).
+				LineTo(glx.Vec2{X: 10, Y: 10}).
+				LineTo(glx.Vec2{X: 10, Y: 0}).
+				LineTo(glx.Vec2{X: 0, Y: 10}).
+				Close(),
+			area: 50,
+		},
+		{
+			name:  "circle",
+			path:  NewPath2d().ArcTo(glx.Vec2{X: 50, Y: 50}, 20, 0, math.Pi*2).Close(),
+			area:  math.Pi * 20 * 20,
+			delta: math.Pi * 2 * 20 * 0.25, // perimeter * tolerance
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			area := 0.0
+			for _, tri := range tt.path.tessellateFill(0.25, tt.rule) {
+				cross := triangleCross(tri)
+				assert.Greater(t, cross, 0.0, "triangle %v must be in clock-wise order", tri)
+				area += cross / 2
+			}
+
+			if tt.delta == 0 {
+				tt.delta = 0.001
+			}
+
+			assert.InDelta(t, tt.area, area, tt.delta)
+		})
+	}
+}
+
+func TestPath2d_Cache(t *testing.T) {
+	path := NewPath2d().
+		MoveTo(glx.Vec2{X: 0, Y: 0}).
+		CubicTo(glx.Vec2{X: 0, Y: 100}, glx.Vec2{X: 100, Y: 100}, glx.Vec2{X: 100, Y: 0}).
+		Close()
+
+	first := path.tessellateFill(0.25, FillRuleNonZero)
+	assert.NotEmpty(t, first)
+
+	// same params (and tolerance quantized to same value) reuse cache
+	assert.Same(t, &first[0], &path.tessellateFill(0.25, FillRuleNonZero)[0])
+	assert.Same(t, &first[0], &path.tessellateFill(0.3, FillRuleNonZero)[0])
+
+	// other params or changed path invalidate cache
+	assert.NotSame(t, &first[0], &path.tessellateFill(0.25, FillRuleEvenOdd)[0])
+	path.LineTo(glx.Vec2{X: 50, Y: -50})
+	assert.NotSame(t, &first[0], &path.tessellateFill(0.25, FillRuleNonZero)[0])
+
+	style := strokeStyle{width: 2, dash: []float32{5, 5}}
+	stroke := path.tessellateStroke(0.25, style)
+	assert.NotEmpty(t, stroke)
+	assert.Same(t, &stroke[0], &path.tessellateStroke(0.25, style)[0])
+
+	style.dash = []float32{5, 4}
+	assert.NotSame(t, &stroke[0], &path.tessellateStroke(0.25, style)[0])
+
+	// bounds
+	min, max := path.Bounds()
+	assert.Equal(t, glx.Vec2{X: 0, Y: -50}, min)
+	assert.Equal(t, glx.Vec2{X: 100, Y: 100}, max)
+
+	path.Reset()
+	assert.True(t, path.IsEmpty())
+	assert.Empty(t, path.tessellateFill(0.25, FillRuleNonZero))
+}
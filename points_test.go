@@ -0,0 +1,69 @@
+package vgl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-glx/glx"
+)
+
+func TestPointsChunks(t *testing.T) {
+	vertexes := func(count int) []shaderInputPoints2dVertex {
+		list := make([]shaderInputPoints2dVertex, 0, count)
+		for i := 0; i < count; i++ {
+			list = append(list, shaderInputPoints2dVertex{
+				pos:   glx.Vec2{X: float32(i), Y: 1},
+				color: glx.Vec4{X: 1, Y: 1, Z: 1, W: 1},
+				size:  3,
+			})
+		}
+
+		return list
+	}
+
+	tests := []struct {
+		name    string
+		count   int
+		chunks  int
+		padding int
+	}{
+		{name: "empty", count: 0, chunks: 0, padding: 0},
+		{name: "one point", count: 1, chunks: 1, padding: pointsChunkSize - 1},
+		{name: "full chunk", count: pointsChunkSize, chunks: 1, padding: 0},
+		{name: "full chunk and one point", count: pointsChunkSize + 1, chunks: 2, padding: pointsChunkSize - 1},
+		{name: "many chunks", count: pointsChunkSize*3 + 10, chunks: 4, padding: pointsChunkSize - 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := vertexes(tt.count)
+			chunks := pointsChunks(input)
+
+			assert.Len(t, chunks, tt.chunks)
+
+			drawn := make([]shaderInputPoints2dVertex, 0, tt.count)
+			padding := 0
+
+			for _, chunk := range chunks {
+				assert.Len(t, chunk, pointsChunkSize)
+
+				for _, vertex := range chunk {
+					if vertex.pos != pointsPaddingPos {
+						drawn = append(drawn, vertex)
+						continue
+					}
+
+					// gl_PointSize 0 is undefined, padding should
+					// have valid size and be invisible
+					assert.Equal(t, float32(1), vertex.size)
+					assert.Equal(t, float32(0), vertex.color.W)
+					padding++
+				}
+			}
+
+			assert.Equal(t, input, drawn)
+			assert.Equal(t, tt.padding, padding)
+		})
+	}
+}
@@ -0,0 +1,546 @@
+package vgl
+
+import (
+	"math"
+	"sort"
+
+	"github.com/go-glx/glx"
+)
+
+const outlineChunkSize = 8
+
+// polygonRing return ring points without consecutive
+// duplicates (including closing point, equal to first)
+func polygonRing(ring []glx.Vec2) []glx.Vec2 {
+	clean := make([]glx.Vec2, 0, len(ring))
+
+	for _, point := range ring {
+		if len(clean) > 0 && clean[len(clean)-1] == point {
+			continue
+		}
+
+		clean = append(clean, point)
+	}
+
+	for len(clean) > 1 && clean[0] == clean[len(clean)-1] {
+		clean = clean[:len(clean)-1]
+	}
+
+	return clean
+}
+
+// outlineChunks split closed ring into line strip chunks for
+// buildIn.outline shader. Every next chunk start from last point
+// of previous chunk, last chunk padded with own last point
+func outlineChunks(ring []glx.Vec2) [][outlineChunkSize]glx.Vec2 {
+	ring = polygonRing(ring)
+	if len(ring) < 2 {
+		return nil
+	}
+
+	strip := append(ring, ring[0])
+	chunks := make([][outlineChunkSize]glx.Vec2, 0, len(strip)/(outlineChunkSize-1)+1)
+
+	for start := 0; start < len(strip)-1; start += outlineChunkSize - 1 {
+		chunk := [outlineChunkSize]glx.Vec2{}
+
+		for i := 0; i < outlineChunkSize; i++ {
+			ind := start + i
+			if ind >= len(strip) {
+				ind = len(strip) - 1
+			}
+
+			chunk[i] = strip[ind]
+		}
+
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+// triangulatePolygon split polygon (outer ring with optional holes) into
+// triangles with ear clipping. Rings can be in any order (cw/ccw), can be
+// concave, contain duplicate or collinear points and touch itself.
+//
+// All result triangles is in screen clock-wise order (same as Draw2dTriangle
+// input), degenerate input (less than 3 unique not collinear points)
+// produce no triangles.
+func triangulatePolygon(outer []glx.Vec2, holes [][]glx.Vec2) [][3]glx.Vec2 {
+	index := 0
+	outerNode := earFilterPoints(earLinkedList(outer, &index, true), nil)
+	if outerNode == nil || outerNode.next == outerNode.prev {
+		return nil
+	}
+
+	if len(holes) > 0 {
+		outerNode = earEliminateHoles(holes, &index, outerNode)
+	}
+
+	triangles := make([][3]glx.Vec2, 0, len(outer)-2)
+	earcutLinked(outerNode, &triangles, 0)
+
+	return triangles
+}
+
+type earNode struct {
+	i          int // unique point index in input data (bridges reuse it)
+	x, y       float64
+	prev, next *earNode
+	steiner    bool
+}
+
+func (n *earNode) vec() glx.Vec2 {
+	return glx.Vec2{X: float32(n.x), Y: float32(n.y)}
+}
+
+func earLinkedList(ring []glx.Vec2, index *int, clockwise bool) *earNode {
+	var last *earNode
+
+	if clockwise == (earSignedArea(ring) > 0) {
+		for i := 0; i < len(ring); i++ {
+			last = earInsertNode(*index+i, ring[i], last)
+		}
+	} else {
+		for i := len(ring) - 1; i >= 0; i-- {
+			last = earInsertNode(*index+i, ring[i], last)
+		}
+	}
+
+	*index += len(ring)
+
+	if last != nil && earEquals(last, last.next) {
+		earRemoveNode(last)
+		last = last.next
+	}
+
+	return last
+}
+
+func earcutLinked(ear *earNode, triangles *[][3]glx.Vec2, pass int) {
+	if ear == nil {
+		return
+	}
+
+	stop := ear
+	for ear.prev != ear.next {
+		prev, next := ear.prev, ear.next
+
+		if earIsEar(ear) {
+			earEmit(triangles, prev, ear, next)
+			earRemoveNode(ear)
+
+			// skipping the next vertex leads to less sliver triangles
+			ear = next.next
+			stop = next.next
+			continue
+		}
+
+		ear = next
+		if ear != stop {
+			continue
+		}
+
+		// no more ears found on this pass
+		switch pass {
+		case 0:
+			// try filtering points and slicing again
+			earcutLinked(earFilterPoints(ear, nil), triangles, 1)
+		case 1:
+			// try to cure small self-intersections
+			ear = earCureLocalIntersections(earFilterPoints(ear, nil), triangles)
+			earcutLinked(ear, triangles, 2)
+		case 2:
+			// as a last resort, try splitting the remaining polygon into two
+			earSplitEarcut(ear, triangles)
+		}
+
+		return
+	}
+}
+
+func earEmit(triangles *[][3]glx.Vec2, a, b, c *earNode) {
+	if earArea(a, b, c) > 0 {
+		a, c = c, a
+	}
+
+	*triangles = append(*triangles, [3]glx.Vec2{a.vec(), b.vec(), c.vec()})
+}
+
+func earIsEar(ear *earNode) bool {
+	a, b, c := ear.prev, ear, ear.next
+	if earArea(a, b, c) >= 0 {
+		return false // reflex, can't be an ear
+	}
+
+	x0, x1 := math.Min(a.x, math.Min(b.x, c.x)), math.Max(a.x, math.Max(b.x, c.x))
+	y0, y1 := math.Min(a.y, math.Min(b.y, c.y)), math.Max(a.y, math.Max(b.y, c.y))
+
+	// ear is valid when no other point inside
+	for p := c.next; p != a; p = p.next {
+		if p.x >= x0 && p.x <= x1 && p.y >= y0 && p.y <= y1 &&
+			earPointInTriangle(a.x, a.y, b.x, b.y, c.x, c.y, p.x, p.y) &&
+			earArea(p.prev, p, p.next) >= 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// earFilterPoints remove duplicate and collinear points
+func earFilterPoints(start, end *earNode) *earNode {
+	if start == nil {
+		return nil
+	}
+	if end == nil {
+		end = start
+	}
+
+	p := start
+	for {
+		again := false
+
+		if !p.steiner && (earEquals(p, p.next) || earArea(p.prev, p, p.next) == 0) {
+			earRemoveNode(p)
+			p = p.prev
+			end = p
+			if p == p.next {
+				break
+			}
+			again = true
+		} else {
+			p = p.next
+		}
+
+		if !again && p == end {
+			break
+		}
+	}
+
+	return end
+}
+
+func earCureLocalIntersections(start *earNode, triangles *[][3]glx.Vec2) *earNode {
+	p := start
+	for {
+		a, b := p.prev, p.next.next
+
+		if !earEquals(a, b) && earIntersects(a, p, p.next, b) && earLocallyInside(a, b) && earLocallyInside(b, a) {
+			earEmit(triangles, a, p, b)
+
+			// remove two nodes involved
+			earRemoveNode(p)
+			earRemoveNode(p.next)
+
+			p = b
+			start = b
+		}
+
+		p = p.next
+		if p == start {
+			break
+		}
+	}
+
+	return earFilterPoints(p, nil)
+}
+
+func earSplitEarcut(start *earNode, triangles *[][3]glx.Vec2) {
+	a := start
+	for {
+		for b := a.next.next; b != a.prev; b = b.next {
+			if a.i != b.i && earIsValidDiagonal(a, b) {
+				c := earSplitPolygon(a, b)
+
+				a = earFilterPoints(a, a.next)
+				c = earFilterPoints(c, c.next)
+
+				earcutLinked(a, triangles, 0)
+				earcutLinked(c, triangles, 0)
+				return
+			}
+		}
+
+		a = a.next
+		if a == start {
+			return
+		}
+	}
+}
+
+// earEliminateHoles link every hole into the outer loop,
+// producing a single-ring polygon without holes
+func earEliminateHoles(holes [][]glx.Vec2, index *int, outerNode *earNode) *earNode {
+	queue := make([]*earNode, 0, len(holes))
+
+	for _, hole := range holes {
+		list := earLinkedList(hole, index, false)
+		if list == nil {
+			continue
+		}
+		if list == list.next {
+			list.steiner = true
+		}
+
+		queue = append(queue, earLeftmost(list))
+	}
+
+	sort.SliceStable(queue, func(i, j int) bool {
+		return queue[i].x < queue[j].x
+	})
+
+	for _, hole := range queue {
+		outerNode = earEliminateHole(hole, outerNode)
+	}
+
+	return outerNode
+}
+
+func earEliminateHole(hole, outerNode *earNode) *earNode {
+	bridge := earFindHoleBridge(hole, outerNode)
+	if bridge == nil {
+		return outerNode
+	}
+
+	bridgeReverse := earSplitPolygon(bridge, hole)
+	earFilterPoints(bridgeReverse, bridgeReverse.next)
+
+	return earFilterPoints(bridge, bridge.next)
+}
+
+// earFindHoleBridge use David Eberly's algorithm for finding a bridge between hole and outer polygon
+func earFindHoleBridge(hole, outerNode *earNode) *earNode {
+	hx, hy := hole.x, hole.y
+	qx := math.Inf(-1)
+
+	var m *earNode
+
+	// find a segment intersected by a ray from the hole's leftmost
+	// point to the left; segment's endpoint with lesser x will be
+	// potential connection point
+	p := outerNode
+	for {
+		if hy <= p.y && hy >= p.next.y && p.next.y != p.y {
+			x := p.x + (hy-p.y)*(p.next.x-p.x)/(p.next.y-p.y)
+			if x <= hx && x > qx {
+				qx = x
+				m = p.next
+				if p.x < p.next.x {
+					m = p
+				}
+				if x == hx {
+					// hole touches outer segment, pick leftmost endpoint
+					return m
+				}
+			}
+		}
+
+		p = p.next
+		if p == outerNode {
+			break
+		}
+	}
+
+	if m == nil {
+		return nil
+	}
+
+	// look for points inside the triangle of hole point, segment
+	// intersection and endpoint; if there are no points found, we
+	// have a valid connection, otherwise choose the point of the
+	// minimum angle with the ray as connection point
+	stop := m
+	mx, my := m.x, m.y
+	tanMin := math.Inf(1)
+
+	p = m
+	for {
+		ax, cx := hx, qx
+		if hy < my {
+			ax, cx = qx, hx
+		}
+
+		if hx >= p.x && p.x >= mx && hx != p.x && earPointInTriangle(ax, hy, mx, my, cx, hy, p.x, p.y) {
+			tan := math.Abs(hy-p.y) / (hx - p.x)
+
+			if earLocallyInside(p, hole) &&
+				(tan < tanMin || (tan == tanMin && (p.x > m.x || (p.x == m.x && earSectorContainsSector(m, p))))) {
+				m = p
+				tanMin = tan
+			}
+		}
+
+		p = p.next
+		if p == stop {
+			break
+		}
+	}
+
+	return m
+}
+
+func earSectorContainsSector(m, p *earNode) bool {
+	return earArea(m.prev, m, p.prev) < 0 && earArea(p.next, m, m.next) < 0
+}
+
+func earLeftmost(start *earNode) *earNode {
+	leftmost := start
+
+	for p := start.next; p != start; p = p.next {
+		if p.x < leftmost.x || (p.x == leftmost.x && p.y < leftmost.y) {
+			leftmost = p
+		}
+	}
+
+	return leftmost
+}
+
+func earPointInTriangle(ax, ay, bx, by, cx, cy, px, py float64) bool {
+	return (cx-px)*(ay-py) >= (ax-px)*(cy-py) &&
+		(ax-px)*(by-py) >= (bx-px)*(ay-py) &&
+		(bx-px)*(cy-py) >= (cx-px)*(by-py)
+}
+
+func earIsValidDiagonal(a, b *earNode) bool {
+	if a.next.i == b.i || a.prev.i == b.i || earIntersectsPolygon(a, b) {
+		return false
+	}
+
+	// locally visible and does not create opposite-facing sectors
+	if earLocallyInside(a, b) && earLocallyInside(b, a) && earMiddleInside(a, b) &&
+		(earArea(a.prev, a, b.prev) != 0 || earArea(a, b.prev, b) != 0) {
+		return true
+	}
+
+	// special zero-length case
+	return earEquals(a, b) && earArea(a.prev, a, a.next) > 0 && earArea(b.prev, b, b.next) > 0
+}
+
+// earArea is signed area of a triangle
+func earArea(p, q, r *earNode) float64 {
+	return (q.y-p.y)*(r.x-q.x) - (q.x-p.x)*(r.y-q.y)
+}
+
+func earEquals(a, b *earNode) bool {
+	return a.x == b.x && a.y == b.y
+}
+
+func earIntersects(p1, q1, p2, q2 *earNode) bool {
+	o1 := earSign(earArea(p1, q1, p2))
+	o2 := earSign(earArea(p1, q1, q2))
+	o3 := earSign(earArea(p2, q2, p1))
+	o4 := earSign(earArea(p2, q2, q1))
+
+	if o1 != o2 && o3 != o4 {
+		return true
+	}
+
+	return (o1 == 0 && earOnSegment(p1, p2, q1)) ||
+		(o2 == 0 && earOnSegment(p1, q2, q1)) ||
+		(o3 == 0 && earOnSegment(p2, p1, q2)) ||
+		(o4 == 0 && earOnSegment(p2, q1, q2))
+}
+
+// earOnSegment check that collinear point q lie on segment pr
+func earOnSegment(p, q, r *earNode) bool {
+	return q.x <= math.Max(p.x, r.x) && q.x >= math.Min(p.x, r.x) &&
+		q.y <= math.Max(p.y, r.y) && q.y >= math.Min(p.y, r.y)
+}
+
+func earSign(v float64) int {
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+func earIntersectsPolygon(a, b *earNode) bool {
+	p := a
+	for {
+		if p.i != a.i && p.next.i != a.i && p.i != b.i && p.next.i != b.i && earIntersects(p, p.next, a, b) {
+			return true
+		}
+
+		p = p.next
+		if p == a {
+			return false
+		}
+	}
+}
+
+func earLocallyInside(a, b *earNode) bool {
+	if earArea(a.prev, a, a.next) < 0 {
+		return earArea(a, b, a.next) >= 0 && earArea(a, a.prev, b) >= 0
+	}
+
+	return earArea(a, b, a.prev) < 0 || earArea(a, a.next, b) < 0
+}
+
+func earMiddleInside(a, b *earNode) bool {
+	px, py := (a.x+b.x)/2, (a.y+b.y)/2
+	inside := false
+
+	p := a
+	for {
+		if (p.y > py) != (p.next.y > py) && p.next.y != p.y &&
+			px < (p.next.x-p.x)*(py-p.y)/(p.next.y-p.y)+p.x {
+			inside = !inside
+		}
+
+		p = p.next
+		if p == a {
+			return inside
+		}
+	}
+}
+
+// earSplitPolygon link two polygon vertices with a bridge; if the vertices belong
+// to the same ring, it splits polygon into two, if one belongs to the outer ring
+// and another to a hole, it merges it into a single ring
+func earSplitPolygon(a, b *earNode) *earNode {
+	a2 := &earNode{i: a.i, x: a.x, y: a.y}
+	b2 := &earNode{i: b.i, x: b.x, y: b.y}
+	an, bp := a.next, b.prev
+
+	a.next, b.prev = b, a
+	a2.next, an.prev = an, a2
+	b2.next, a2.prev = a2, b2
+	bp.next, b2.prev = b2, bp
+
+	return b2
+}
+
+func earInsertNode(i int, pos glx.Vec2, last *earNode) *earNode {
+	p := &earNode{i: i, x: float64(pos.X), y: float64(pos.Y)}
+
+	if last == nil {
+		p.prev, p.next = p, p
+		return p
+	}
+
+	p.next, p.prev = last.next, last
+	last.next.prev = p
+	last.next = p
+
+	return p
+}
+
+func earRemoveNode(p *earNode) {
+	p.next.prev = p.prev
+	p.prev.next = p.next
+}
+
+func earSignedArea(ring []glx.Vec2) float64 {
+	sum := 0.0
+
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		sum += float64(ring[j].X-ring[i].X) * float64(ring[i].Y+ring[j].Y)
+	}
+
+	return sum
+}
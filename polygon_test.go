@@ -0,0 +1,153 @@
+package vgl
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-glx/glx"
+)
+
+func TestTriangulatePolygon(t *testing.T) {
+	square := []glx.Vec2{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 10}, {X: 0, Y: 10}}
+	squareHole := []glx.Vec2{{X: 3, Y: 3}, {X: 3, Y: 7}, {X: 7, Y: 7}, {X: 7, Y: 3}}
+
+	tests := []struct {
+		name      string
+		outer     []glx.Vec2
+		holes     [][]glx.Vec2
+		triangles int
+		area      float64
+	}{
+		{name: "empty", outer: nil, triangles: 0, area: 0},
+		{name: "two points", outer: square[:2], triangles: 0, area: 0},
+		{name: "triangle", outer: square[:3], triangles: 1, area: 50},
+		{name: "square cw", outer: square, triangles: 2, area: 100},
+		{name: "square ccw", outer: []glx.Vec2{square[3], square[2], square[1], square[0]}, triangles: 2, area: 100},
+		{
+			name:      "concave L shape",
+			outer:     []glx.Vec2{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 5}, {X: 5, Y: 5}, {X: 5, Y: 10}, {X: 0, Y: 10}},
+			triangles: 4,
+			area:      75,
+		},
+		{name: "square with hole", outer: square, holes: [][]glx.Vec2{squareHole}, triangles: 8, area: 84},
+		{name: "square with degenerate hole", outer: square, holes: [][]glx.Vec2{{{X: 3, Y: 3}, {X: 3, Y: 3}}}, area: 100},
+
+		// degenerate input
+		{
+			name:  "all points collinear",
+			outer: []glx.Vec2{{X: 0, Y: 0}, {X: 5, Y: 5}, {X: 10, Y: 10}, {X: 20, Y: 20}},
+			area:  0,
+		},
+		{
+			name:      "collinear points on edges",
+			outer:     []glx.Vec2{{X: 0, Y: 0}, {X: 5, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 5}, {X: 10, Y: 10}, {X: 0, Y: 10}},
+			triangles: 2,
+			area:      100,
+		},
+		{
+			name:      "duplicate points",
+			outer:     []glx.Vec2{{X: 0, Y: 0}, {X: 0, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 10}, {X: 10, Y: 10}, {X: 0, Y: 10}, {X: 0, Y: 0}},
+			triangles: 2,
+			area:      100,
+		},
+		{
+			name:  "all points same",
+			outer: []glx.Vec2{{X: 5, Y: 5}, {X: 5, Y: 5}, {X: 5, Y: 5}},
+			area:  0,
+		},
+		{
+			name: "self touching ring (bow tie by vertex)",
+			outer: []glx.Vec2{
+				{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 5, Y: 5},
+				{X: 10, Y: 10}, {X: 0, Y: 10}, {X: 5, Y: 5},
+			},
+			triangles: 2,
+			area:      50,
+		},
+		{
+			name:  "hole touching outer ring",
+			outer: square,
+			holes: [][]glx.Vec2{
+				{{X: 0, Y: 5}, {X: 5, Y: 3}, {X: 5, Y: 7}},
+			},
+			area: 90,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := triangulatePolygon(tt.outer, tt.holes)
+
+			if tt.triangles > 0 {
+				assert.Len(t, got, tt.triangles)
+			}
+
+			area := 0.0
+			for _, tri := range got {
+				cross := triangleCross(tri)
+				assert.GreaterOrEqual(t, cross, 0.0, "triangle %v must be in clock-wise order", tri)
+				area += cross / 2
+			}
+
+			assert.InDelta(t, tt.area, area, 0.0001)
+
+			// deterministic
+			assert.Equal(t, got, triangulatePolygon(tt.outer, tt.holes))
+		})
+	}
+}
+
+func TestOutlineChunks(t *testing.T) {
+	ring := func(n int) []glx.Vec2 {
+		points := make([]glx.Vec2, 0, n)
+		for i := 0; i < n; i++ {
+			angle := float64(i) / float64(n) * math.Pi * 2
+			points = append(points, glx.Vec2{X: float32(math.Cos(angle)), Y: float32(math.Sin(angle))})
+		}
+
+		return points
+	}
+
+	tests := []struct {
+		name   string
+		ring   []glx.Vec2
+		chunks int
+	}{
+		{name: "empty", ring: nil, chunks: 0},
+		{name: "single point", ring: ring(1), chunks: 0},
+		{name: "duplicates only", ring: []glx.Vec2{{X: 1, Y: 1}, {X: 1, Y: 1}}, chunks: 0},
+		{name: "triangle", ring: ring(3), chunks: 1},
+		{name: "closed triangle", ring: append(ring(3), ring(3)[0]), chunks: 1},
+		{name: "exactly one chunk", ring: ring(7), chunks: 1},
+		{name: "two chunks", ring: ring(8), chunks: 2},
+		{name: "many chunks", ring: ring(64), chunks: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := outlineChunks(tt.ring)
+			assert.Len(t, got, tt.chunks)
+
+			if len(got) == 0 {
+				return
+			}
+
+			// strip must be continuous and closed
+			first := got[0][0]
+			for i := 1; i < len(got); i++ {
+				assert.Equal(t, got[i-1][outlineChunkSize-1], got[i][0])
+			}
+
+			assert.Equal(t, first, got[len(got)-1][outlineChunkSize-1])
+		})
+	}
+}
+
+func triangleCross(tri [3]glx.Vec2) float64 {
+	ab := tri[1].Sub(tri[0])
+	ac := tri[2].Sub(tri[0])
+
+	return float64(ab.X)*float64(ac.Y) - float64(ab.Y)*float64(ac.X)
+}
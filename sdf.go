@@ -0,0 +1,169 @@
+package vgl
+
+import (
+	"math"
+
+	"github.com/go-glx/glx"
+)
+
+const (
+	sdfShapeRoundedRect sdfShapeKind = iota
+	sdfShapeCapsule
+	sdfShapeNgon
+)
+
+const sdfNgonDefaultSides = 6
+
+type (
+	sdfShapeKind uint8
+
+	// sdfShape is quad with distance function, that drawn by buildIn.sdf shader
+	// all sizes in world pixels, shape local space is not rotated and centered
+	sdfShape struct {
+		kind     sdfShapeKind
+		center   glx.Vec2
+		rotation float64  // radians
+		extent   glx.Vec2 // half size of shape bounding box in local space
+		size     glx.Vec4 // shape size params (see sdf2d.frag)
+		radius   glx.Vec4 // shape radius params (see sdf2d.frag)
+	}
+
+	sdfStyle struct {
+		color        glx.Vec4
+		outlineWidth float32
+		outlineColor glx.Vec4
+		shadowOffset glx.Vec2
+		shadowBlur   float32
+		shadowColor  glx.Vec4
+		gradient     *Gradient // fill gradient (instead of color)
+	}
+)
+
+// newSDFRoundedRect: corner radius (tl, tr, br, bl) is clamped to half of smaller side
+func newSDFRoundedRect(center glx.Vec2, halfSize glx.Vec2, rotation float64, radius [4]float32) sdfShape {
+	maxRadius := float32(math.Min(float64(halfSize.X), float64(halfSize.Y)))
+	for i := range radius {
+		radius[i] = glx.Clamp(radius[i], 0, maxRadius)
+	}
+
+	return sdfShape{
+		kind:     sdfShapeRoundedRect,
+		center:   center,
+		rotation: rotation,
+		extent:   halfSize,
+		size:     glx.Vec4{X: halfSize.X, Y: halfSize.Y},
+		radius:   glx.Vec4{X: radius[0], Y: radius[1], Z: radius[2], W: radius[3]},
+	}
+}
+
+// newSDFCapsule: local x axis is directed from a to b
+func newSDFCapsule(a, b glx.Vec2, radius float32) sdfShape {
+	halfLength := float32(math.Hypot(float64(b.X-a.X), float64(b.Y-a.Y))) / 2
+
+	return sdfShape{
+		kind:     sdfShapeCapsule,
+		center:   a.Add(b).Scale(0.5),
+		rotation: math.Atan2(float64(b.Y-a.Y), float64(b.X-a.X)),
+		extent:   glx.Vec2{X: halfLength + radius, Y: radius},
+		size:     glx.Vec4{X: halfLength},
+		radius:   glx.Vec4{X: radius},
+	}
+}
+
+// newSDFNgon: regular polygon with first vertex pointing up, corner rounding
+// is clamped to inscribed circle radius (fully rounded ngon is circle)
+func newSDFNgon(center glx.Vec2, radius float32, sides int, rotation float64, rounding float32) sdfShape {
+	if sides <= 0 {
+		sides = sdfNgonDefaultSides
+	}
+
+	if sides < 3 {
+		sides = 3
+	}
+
+	inradius := radius * float32(math.Cos(math.Pi/float64(sides)))
+
+	return sdfShape{
+		kind:     sdfShapeNgon,
+		center:   center,
+		rotation: rotation,
+		extent:   glx.Vec2{X: radius, Y: radius},
+		size:     glx.Vec4{X: radius, Y: float32(sides)},
+		radius:   glx.Vec4{X: glx.Clamp(rounding, 0, inradius)},
+	}
+}
+
+// quad returns clock-wise world positions and local positions of quad, that
+// cover shape, its shadow and anti-aliasing margin
+func (s *sdfShape) quad(style *sdfStyle, margin float32) (pos [4]glx.Vec2, local [4]glx.Vec2) {
+	sin, cos := math.Sincos(s.rotation)
+	lo := glx.Vec2{X: -s.extent.X - margin, Y: -s.extent.Y - margin}
+	hi := glx.Vec2{X: s.extent.X + margin, Y: s.extent.Y + margin}
+
+	if style.shadowColor.W > 0 {
+		offset := s.shadowLocalOffset(style, sin, cos)
+		blur := style.shadowBlur
+
+		lo.X = float32(math.Min(float64(lo.X), float64(offset.X-s.extent.X-blur-margin)))
+		lo.Y = float32(math.Min(float64(lo.Y), float64(offset.Y-s.extent.Y-blur-margin)))
+		hi.X = float32(math.Max(float64(hi.X), float64(offset.X+s.extent.X+blur+margin)))
+		hi.Y = float32(math.Max(float64(hi.Y), float64(offset.Y+s.extent.Y+blur+margin)))
+	}
+
+	local = [4]glx.Vec2{
+		{X: lo.X, Y: lo.Y}, // tl
+		{X: hi.X, Y: lo.Y}, // tr
+		{X: hi.X, Y: hi.Y}, // br
+		{X: lo.X, Y: hi.Y}, // bl
+	}
+
+	for i, point := range local {
+		pos[i] = s.center.Add(glx.Vec2{
+			X: point.X*float32(cos) - point.Y*float32(sin),
+			Y: point.X*float32(sin) + point.Y*float32(cos),
+		})
+	}
+
+	return pos, local
+}
+
+// shadowLocalOffset convert shadow offset from world space to
+// shape local space, so shadow direction not depend on shape rotation
+func (s *sdfShape) shadowLocalOffset(style *sdfStyle, sin, cos float64) glx.Vec2 {
+	return glx.Vec2{
+		X: style.shadowOffset.X*float32(cos) + style.shadowOffset.Y*float32(sin),
+		Y: -style.shadowOffset.X*float32(sin) + style.shadowOffset.Y*float32(cos),
+	}
+}
+
+func (s *sdfShape) shaderInput(style *sdfStyle, margin float32) *shaderInputSDF2d {
+	pos, local := s.quad(style, margin)
+	offset := s.shadowLocalOffset(style, math.Sin(s.rotation), math.Cos(s.rotation))
+
+	input := &shaderInputSDF2d{
+		vertexes:     make([]shaderInputSDF2dVertex, 0, 4),
+		outlineColor: style.outlineColor,
+		shadowColor:  style.shadowColor,
+		size:         s.size,
+		radius:       s.radius,
+		params:       glx.Vec4{X: float32(s.kind), Y: style.outlineWidth, Z: style.shadowBlur},
+		shadowOffset: glx.Vec4{X: offset.X, Y: offset.Y},
+	}
+
+	if style.gradient != nil {
+		// gradient is relative to shape box (without shadow and margin)
+		box := ellipseQuad(s.center, s.extent, s.rotation)
+		min, max := pointsBounds(box[:])
+		input.gradient = style.gradient.resolve(min, max)
+	}
+
+	for i := range pos {
+		input.vertexes = append(input.vertexes, shaderInputSDF2dVertex{
+			pos:   pos[i],
+			color: style.color,
+			local: local[i],
+		})
+	}
+
+	return input
+}
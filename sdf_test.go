@@ -0,0 +1,112 @@
+package vgl
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-glx/glx"
+)
+
+func TestSDFShape_Params(t *testing.T) {
+	tests := []struct {
+		name   string
+		shape  sdfShape
+		center glx.Vec2
+		extent glx.Vec2
+		size   glx.Vec4
+		radius glx.Vec4
+	}{
+		{
+			name:   "rounded rect",
+			shape:  newSDFRoundedRect(glx.Vec2{X: 50, Y: 20}, glx.Vec2{X: 40, Y: 10}, 0, [4]float32{5, 0, 20, -1}),
+			center: glx.Vec2{X: 50, Y: 20},
+			extent: glx.Vec2{X: 40, Y: 10},
+			size:   glx.Vec4{X: 40, Y: 10},
+			radius: glx.Vec4{X: 5, Y: 0, Z: 10, W: 0}, // clamped to half of smaller side
+		},
+		{
+			name:   "capsule",
+			shape:  newSDFCapsule(glx.Vec2{X: 10, Y: 10}, glx.Vec2{X: 10, Y: 50}, 5),
+			center: glx.Vec2{X: 10, Y: 30},
+			extent: glx.Vec2{X: 25, Y: 5},
+			size:   glx.Vec4{X: 20},
+			radius: glx.Vec4{X: 5},
+		},
+		{
+			name:   "ngon default sides",
+			shape:  newSDFNgon(glx.Vec2{}, 10, 0, 0, 2),
+			extent: glx.Vec2{X: 10, Y: 10},
+			size:   glx.Vec4{X: 10, Y: 6},
+			radius: glx.Vec4{X: 2},
+		},
+		{
+			name:   "triangle fully rounded",
+			shape:  newSDFNgon(glx.Vec2{}, 10, 3, 0, 100),
+			extent: glx.Vec2{X: 10, Y: 10},
+			size:   glx.Vec4{X: 10, Y: 3},
+			radius: glx.Vec4{X: 5}, // inscribed circle
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.center.X, tt.shape.center.X, 0.0001)
+			assert.InDelta(t, tt.center.Y, tt.shape.center.Y, 0.0001)
+			assert.InDelta(t, tt.extent.X, tt.shape.extent.X, 0.0001)
+			assert.InDelta(t, tt.extent.Y, tt.shape.extent.Y, 0.0001)
+			assert.InDeltaSlice(t, []float32{tt.size.X, tt.size.Y, tt.size.Z, tt.size.W}, []float32{tt.shape.size.X, tt.shape.size.Y, tt.shape.size.Z, tt.shape.size.W}, 0.0001)
+			assert.InDeltaSlice(t, []float32{tt.radius.X, tt.radius.Y, tt.radius.Z, tt.radius.W}, []float32{tt.shape.radius.X, tt.shape.radius.Y, tt.shape.radius.Z, tt.shape.radius.W}, 0.0001)
+		})
+	}
+}
+
+func TestSDFShape_Quad(t *testing.T) {
+	tests := []struct {
+		name     string
+		rotation float64
+		style    sdfStyle
+		local    [2]glx.Vec2 // tl, br
+		world    [2]glx.Vec2 // tl, br
+	}{
+		{
+			name:  "without shadow",
+			local: [2]glx.Vec2{{X: -11, Y: -6}, {X: 11, Y: 6}},
+			world: [2]glx.Vec2{{X: 89, Y: 94}, {X: 111, Y: 106}},
+		},
+		{
+			name:  "shadow extends quad",
+			style: sdfStyle{shadowColor: glx.Vec4{W: 1}, shadowOffset: glx.Vec2{X: 4, Y: -2}, shadowBlur: 3},
+			local: [2]glx.Vec2{{X: -11, Y: -11}, {X: 18, Y: 7}},
+			world: [2]glx.Vec2{{X: 89, Y: 89}, {X: 118, Y: 107}},
+		},
+		{
+			name:     "rotated shape keeps shadow direction",
+			rotation: math.Pi / 2,
+			style:    sdfStyle{shadowColor: glx.Vec4{W: 1}, shadowOffset: glx.Vec2{X: 4}},
+			local:    [2]glx.Vec2{{X: -11, Y: -10}, {X: 11, Y: 6}},
+			world:    [2]glx.Vec2{{X: 110, Y: 89}, {X: 94, Y: 111}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shape := newSDFRoundedRect(glx.Vec2{X: 100, Y: 100}, glx.Vec2{X: 10, Y: 5}, tt.rotation, [4]float32{})
+			pos, local := shape.quad(&tt.style, 1)
+
+			assert.InDelta(t, tt.local[0].X, local[0].X, 0.0001)
+			assert.InDelta(t, tt.local[0].Y, local[0].Y, 0.0001)
+			assert.InDelta(t, tt.local[1].X, local[2].X, 0.0001)
+			assert.InDelta(t, tt.local[1].Y, local[2].Y, 0.0001)
+			assert.InDelta(t, tt.world[0].X, pos[0].X, 0.0001)
+			assert.InDelta(t, tt.world[0].Y, pos[0].Y, 0.0001)
+			assert.InDelta(t, tt.world[1].X, pos[2].X, 0.0001)
+			assert.InDelta(t, tt.world[1].Y, pos[2].Y, 0.0001)
+
+			// screen clock-wise order
+			cross := (pos[1].X-pos[0].X)*(pos[2].Y-pos[0].Y) - (pos[1].Y-pos[0].Y)*(pos[2].X-pos[0].X)
+			assert.Greater(t, cross, float32(0))
+		})
+	}
+}
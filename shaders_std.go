@@ -3,15 +3,40 @@ package vgl
 const (
 	buildInShaderPoint    = "buildIn.point"
 	buildInShaderLine     = "buildIn.line"
+	buildInShaderLineAA   = "buildIn.lineAA"
 	buildInShaderTriangle = "buildIn.triangle"
 	buildInShaderCircle   = "buildIn.circle"
 	buildInShaderRect     = "buildIn.rect"
+	buildInShaderTexture  = "buildIn.texture"
+	buildInShaderOutline  = "buildIn.outline"
+	buildInShaderMSDF     = "buildIn.msdf"
+	buildInShaderSDF      = "buildIn.sdf"
+	buildInShaderGradient = "buildIn.gradient"
+
+	buildInShaderPointSized = "buildIn.pointSized"
+	buildInShaderPoints     = "buildIn.points"
 )
 
+// shaderInput is instance data of buildIn shaders, that
+// can be transformed by Render transform stack
+type shaderInput interface {
+	VertexData() []byte
+	StorageData() []byte
+	transform(t *transform2d)
+}
+
 var stdShaders = []ParamsRegisterShader{
 	stdShaderPoint,
 	stdShaderLine,
+	stdShaderLineAA,
 	stdShaderTriangle,
 	stdShaderCircle,
 	stdShaderRect,
+	stdShaderTexture,
+	stdShaderOutline,
+	stdShaderMSDF,
+	stdShaderSDF,
+	stdShaderGradient,
+	stdShaderPointSized,
+	stdShaderPoints,
 }
@@ -35,11 +35,27 @@ var (
 	}
 )
 
+// circle shader mode flags (see circle2d.frag)
+const (
+	circleModeEllipse   circleMode = 1 << iota // radius/ringWidth in pixels
+	circleModeArc                              // cut by arc angles
+	circleModeRoundEnds                        // arc ends is rounded
+	circleModePie                              // arc is pie sector
+)
+
 type (
+	circleMode uint8
+
 	shaderInputCircle2d struct {
 		vertexes  []shaderInputCircle2dVertex
 		thickness glx.Vec1
 		smooth    glx.Vec1
+
+		// ellipse/arc only (mode != 0)
+		mode      circleMode
+		ringWidth float32  // ring thickness in pixels (0 = filled)
+		radius    glx.Vec2 // radius in pixels
+		arc       glx.Vec2 // middle angle, half of arc angle
 	}
 
 	shaderInputCircle2dVertex struct {
@@ -61,12 +77,18 @@ func (d *shaderInputCircle2d) VertexData() []byte {
 }
 
 func (d *shaderInputCircle2d) StorageData() []byte {
-	buff := make([]byte, 0, glx.SizeOfVec1*2)
+	params := glx.Vec2{X: float32(d.mode), Y: d.ringWidth}
+	buff := make([]byte, 0, glx.SizeOfVec1*2+glx.SizeOfVec2*3)
 
 	// 8
 	buff = append(buff, d.thickness.Data()...)
 	buff = append(buff, d.smooth.Data()...)
 
+	// 32 (vec2 is aligned to 8 bytes, so struct is aligned without padding)
+	buff = append(buff, params.Data()...)
+	buff = append(buff, d.radius.Data()...)
+	buff = append(buff, d.arc.Data()...)
+
 	// example:
 	// buff = append(buff, bytes.Repeat([]byte("0"), 4)...) // align to 8 bytes
 	// todo: move align outside of shader definition
@@ -74,3 +96,9 @@ func (d *shaderInputCircle2d) StorageData() []byte {
 
 	return buff
 }
+
+func (d *shaderInputCircle2d) transform(t *transform2d) {
+	for i := range d.vertexes {
+		d.vertexes[i].pos = t.apply(d.vertexes[i].pos)
+	}
+}
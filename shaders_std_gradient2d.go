@@ -0,0 +1,58 @@
+package vgl
+
+import (
+	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/glx"
+	"github.com/go-glx/vgl/internal/shaders"
+)
+
+var (
+	stdShaderGradient = ParamsRegisterShader{
+		ShaderName:       buildInShaderGradient,
+		ProgramVert:      shaders.Gradient2DVertSpv(),
+		ProgramFrag:      shaders.Gradient2DFragSpv(),
+		Topology:         vulkan.PrimitiveTopologyTriangleList,
+		TopologyRestarts: false,
+		InputLayout: ParamsRegisterShaderInputLayout{
+			VertexCount:   3,
+			VertexBinding: universal2dBindings,
+			Indexes:       []uint16{0, 1, 2},
+		},
+	}
+)
+
+type (
+	// shaderInputGradient2d is triangle filled with gradient
+	// (vertex color is not used, but required by input layout)
+	shaderInputGradient2d struct {
+		vertexes [3]glx.Vec2
+		gradient shaderGradient
+	}
+)
+
+func (d *shaderInputGradient2d) VertexData() []byte {
+	const vertSize = glx.SizeOfVec2 + glx.SizeOfVec4
+	buff := make([]byte, 0, stdShaderGradient.InputLayout.VertexCount*vertSize)
+
+	color := glx.Vec4{}
+	for _, vertex := range d.vertexes {
+		buff = append(buff, vertex.Data()...)
+		buff = append(buff, color.Data()...)
+	}
+
+	return buff
+}
+
+func (d *shaderInputGradient2d) StorageData() []byte {
+	// 192 (all fields is vec4, so struct is aligned without padding)
+	return d.gradient.Data()
+}
+
+func (d *shaderInputGradient2d) transform(t *transform2d) {
+	for i := range d.vertexes {
+		d.vertexes[i] = t.apply(d.vertexes[i])
+	}
+
+	d.gradient.transform(t)
+}
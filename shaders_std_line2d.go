@@ -0,0 +1,83 @@
+package vgl
+
+import (
+	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/glx"
+	"github.com/go-glx/vgl/internal/shaders"
+)
+
+var (
+	stdShaderLineAA = ParamsRegisterShader{
+		ShaderName:       buildInShaderLineAA,
+		ProgramVert:      shaders.Line2DVertSpv(),
+		ProgramFrag:      shaders.Line2DFragSpv(),
+		Topology:         vulkan.PrimitiveTopologyTriangleList,
+		TopologyRestarts: false,
+		InputLayout: ParamsRegisterShaderInputLayout{
+			VertexCount: 4,
+			VertexBinding: []ParamsRegisterShaderInputVertexBinding{
+				{
+					// x, y
+					Location: 0,
+					Size:     glx.SizeOfVec2,
+					Format:   vulkan.FormatR32g32Sfloat,
+				},
+				{
+					// r, g, b, a
+					Location: 1,
+					Size:     glx.SizeOfVec4,
+					Format:   vulkan.FormatR32g32b32a32Sfloat,
+				},
+				{
+					// line local x, y
+					Location: 2,
+					Size:     glx.SizeOfVec2,
+					Format:   vulkan.FormatR32g32Sfloat,
+				},
+			},
+			Indexes: []uint16{0, 1, 2, 2, 3, 0},
+		},
+	}
+)
+
+type (
+	shaderInputLine2d struct {
+		vertexes   []shaderInputLine2dVertex
+		halfLength float32
+		halfWidth  float32
+		cap        LineCap
+	}
+
+	shaderInputLine2dVertex struct {
+		pos   glx.Vec2
+		color glx.Vec4
+		local glx.Vec2
+	}
+)
+
+func (d *shaderInputLine2d) VertexData() []byte {
+	const vertSize = glx.SizeOfVec2 + glx.SizeOfVec4 + glx.SizeOfVec2
+	buff := make([]byte, 0, stdShaderLineAA.InputLayout.VertexCount*vertSize)
+
+	for _, vertex := range d.vertexes {
+		buff = append(buff, vertex.pos.Data()...)
+		buff = append(buff, vertex.color.Data()...)
+		buff = append(buff, vertex.local.Data()...)
+	}
+
+	return buff
+}
+
+func (d *shaderInputLine2d) StorageData() []byte {
+	params := glx.Vec4{X: d.halfLength, Y: d.halfWidth, Z: float32(d.cap)}
+
+	// 16
+	return params.Data()
+}
+
+func (d *shaderInputLine2d) transform(t *transform2d) {
+	for i := range d.vertexes {
+		d.vertexes[i].pos = t.apply(d.vertexes[i].pos)
+	}
+}
@@ -0,0 +1,94 @@
+package vgl
+
+import (
+	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/glx"
+	"github.com/go-glx/vgl/internal/shaders"
+)
+
+var (
+	stdShaderMSDF = ParamsRegisterShader{
+		ShaderName:       buildInShaderMSDF,
+		ProgramVert:      shaders.MSDF2DVertSpv(),
+		ProgramFrag:      shaders.MSDF2DFragSpv(),
+		Topology:         vulkan.PrimitiveTopologyTriangleList,
+		TopologyRestarts: false,
+		InputLayout: ParamsRegisterShaderInputLayout{
+			VertexCount: 4,
+			VertexBinding: []ParamsRegisterShaderInputVertexBinding{
+				{
+					// x, y
+					Location: 0,
+					Size:     glx.SizeOfVec2,
+					Format:   vulkan.FormatR32g32Sfloat,
+				},
+				{
+					// r, g, b, a
+					Location: 1,
+					Size:     glx.SizeOfVec4,
+					Format:   vulkan.FormatR32g32b32a32Sfloat,
+				},
+				{
+					// texture u, v
+					Location: 2,
+					Size:     glx.SizeOfVec2,
+					Format:   vulkan.FormatR32g32Sfloat,
+				},
+			},
+			Indexes: []uint16{0, 1, 2, 2, 3, 0},
+		},
+	}
+)
+
+type (
+	shaderInputMSDF2d struct {
+		vertexes      []shaderInputMSDF2dVertex
+		outlineColor  glx.Vec4
+		shadowColor   glx.Vec4
+		distanceRange float32
+		outlineWidth  float32
+		shadowSoft    float32
+		shadowOffset  glx.Vec2
+	}
+
+	shaderInputMSDF2dVertex struct {
+		pos   glx.Vec2
+		color glx.Vec4
+		uv    glx.Vec2
+	}
+)
+
+func (d *shaderInputMSDF2d) VertexData() []byte {
+	const vertSize = glx.SizeOfVec2 + glx.SizeOfVec4 + glx.SizeOfVec2
+	buff := make([]byte, 0, stdShaderMSDF.InputLayout.VertexCount*vertSize)
+
+	for _, vertex := range d.vertexes {
+		buff = append(buff, vertex.pos.Data()...)
+		buff = append(buff, vertex.color.Data()...)
+		buff = append(buff, vertex.uv.Data()...)
+	}
+
+	return buff
+}
+
+func (d *shaderInputMSDF2d) StorageData() []byte {
+	params := glx.Vec4{X: d.distanceRange, Y: d.outlineWidth, Z: d.shadowSoft}
+	offset := glx.Vec4{X: d.shadowOffset.X, Y: d.shadowOffset.Y}
+
+	buff := make([]byte, 0, glx.SizeOfVec4*4)
+
+	// 64 (all fields is vec4, so struct is aligned without padding)
+	buff = append(buff, d.outlineColor.Data()...)
+	buff = append(buff, d.shadowColor.Data()...)
+	buff = append(buff, params.Data()...)
+	buff = append(buff, offset.Data()...)
+
+	return buff
+}
+
+func (d *shaderInputMSDF2d) transform(t *transform2d) {
+	for i := range d.vertexes {
+		d.vertexes[i].pos = t.apply(d.vertexes[i].pos)
+	}
+}
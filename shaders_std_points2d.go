@@ -0,0 +1,144 @@
+package vgl
+
+import (
+	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/glx"
+	"github.com/go-glx/vgl/internal/shaders"
+)
+
+// pointsChunkSize is count of points in one Draw2dPoints instance,
+// shorter chunks padded by invisible points (see pointsChunks)
+const pointsChunkSize = 256
+
+// pointsPaddingPos is position of padding points, far outside
+// of any surface, so they clipped before rasterization
+var pointsPaddingPos = glx.Vec2{X: -1e7, Y: -1e7}
+
+var points2dBindings = []ParamsRegisterShaderInputVertexBinding{
+	{
+		// position vec2 x,y
+		Location: 0,
+		Size:     glx.SizeOfVec2,
+		Format:   vulkan.FormatR32g32Sfloat,
+	},
+	{
+		// color vec4 r,g,b,a
+		Location: 1,
+		Size:     glx.SizeOfVec4,
+		Format:   vulkan.FormatR32g32b32a32Sfloat,
+	},
+	{
+		// params vec2 size,shape
+		Location: 2,
+		Size:     glx.SizeOfVec2,
+		Format:   vulkan.FormatR32g32Sfloat,
+	},
+}
+
+var (
+	stdShaderPointSized = ParamsRegisterShader{
+		ShaderName:       buildInShaderPointSized,
+		ProgramVert:      shaders.Points2DVertSpv(),
+		ProgramFrag:      shaders.Points2DFragSpv(),
+		Topology:         vulkan.PrimitiveTopologyPointList,
+		TopologyRestarts: false,
+		InputLayout: ParamsRegisterShaderInputLayout{
+			VertexCount:   1,
+			VertexBinding: points2dBindings,
+			Indexes:       []uint16{0},
+		},
+	}
+
+	stdShaderPoints = ParamsRegisterShader{
+		ShaderName:       buildInShaderPoints,
+		ProgramVert:      shaders.Points2DVertSpv(),
+		ProgramFrag:      shaders.Points2DFragSpv(),
+		Topology:         vulkan.PrimitiveTopologyPointList,
+		TopologyRestarts: false,
+		InputLayout: ParamsRegisterShaderInputLayout{
+			VertexCount:   pointsChunkSize,
+			VertexBinding: points2dBindings,
+			Indexes:       pointsChunkIndexes(),
+		},
+	}
+)
+
+type (
+	shaderInputPoints2d struct {
+		vertexes []shaderInputPoints2dVertex
+	}
+
+	shaderInputPoints2dVertex struct {
+		pos   glx.Vec2
+		color glx.Vec4
+		size  float32
+		shape PointShape
+	}
+)
+
+func pointsChunkIndexes() []uint16 {
+	indexes := make([]uint16, pointsChunkSize)
+	for i := range indexes {
+		indexes[i] = uint16(i)
+	}
+
+	return indexes
+}
+
+// pointsChunks will split vertexes to chunks of pointsChunkSize, last
+// chunk is padded with invisible points. Padding point has valid size 1
+// (gl_PointSize 0 is undefined), zero alpha and position outside
+// of surface, so it's not visible with any blend mode
+func pointsChunks(vertexes []shaderInputPoints2dVertex) [][]shaderInputPoints2dVertex {
+	chunks := make([][]shaderInputPoints2dVertex, 0, (len(vertexes)+pointsChunkSize-1)/pointsChunkSize)
+
+	for start := 0; start < len(vertexes); start += pointsChunkSize {
+		end := start + pointsChunkSize
+		if end > len(vertexes) {
+			end = len(vertexes)
+		}
+
+		chunk := make([]shaderInputPoints2dVertex, 0, pointsChunkSize)
+		chunk = append(chunk, vertexes[start:end]...)
+
+		for len(chunk) < pointsChunkSize {
+			chunk = append(chunk, shaderInputPoints2dVertex{
+				pos:  pointsPaddingPos,
+				size: 1,
+			})
+		}
+
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+func (d *shaderInputPoints2d) VertexData() []byte {
+	const vertSize = glx.SizeOfVec2 + glx.SizeOfVec4 + glx.SizeOfVec2
+	buff := make([]byte, 0, len(d.vertexes)*vertSize)
+
+	for _, vertex := range d.vertexes {
+		params := glx.Vec2{X: vertex.size, Y: float32(vertex.shape)}
+
+		buff = append(buff, vertex.pos.Data()...)
+		buff = append(buff, vertex.color.Data()...)
+		buff = append(buff, params.Data()...)
+	}
+
+	return buff
+}
+
+func (d *shaderInputPoints2d) StorageData() []byte {
+	return nil
+}
+
+func (d *shaderInputPoints2d) transform(t *transform2d) {
+	scale := t.scale()
+
+	for i := range d.vertexes {
+		d.vertexes[i].pos = t.apply(d.vertexes[i].pos)
+		d.vertexes[i].size *= scale
+	}
+}
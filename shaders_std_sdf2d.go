@@ -0,0 +1,98 @@
+package vgl
+
+import (
+	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/glx"
+	"github.com/go-glx/vgl/internal/shaders"
+)
+
+var (
+	stdShaderSDF = ParamsRegisterShader{
+		ShaderName:       buildInShaderSDF,
+		ProgramVert:      shaders.SDF2DVertSpv(),
+		ProgramFrag:      shaders.SDF2DFragSpv(),
+		Topology:         vulkan.PrimitiveTopologyTriangleList,
+		TopologyRestarts: false,
+		InputLayout: ParamsRegisterShaderInputLayout{
+			VertexCount: 4,
+			VertexBinding: []ParamsRegisterShaderInputVertexBinding{
+				{
+					// x, y
+					Location: 0,
+					Size:     glx.SizeOfVec2,
+					Format:   vulkan.FormatR32g32Sfloat,
+				},
+				{
+					// r, g, b, a
+					Location: 1,
+					Size:     glx.SizeOfVec4,
+					Format:   vulkan.FormatR32g32b32a32Sfloat,
+				},
+				{
+					// shape local x, y
+					Location: 2,
+					Size:     glx.SizeOfVec2,
+					Format:   vulkan.FormatR32g32Sfloat,
+				},
+			},
+			Indexes: []uint16{0, 1, 2, 2, 3, 0},
+		},
+	}
+)
+
+type (
+	shaderInputSDF2d struct {
+		vertexes     []shaderInputSDF2dVertex
+		outlineColor glx.Vec4
+		shadowColor  glx.Vec4
+		size         glx.Vec4
+		radius       glx.Vec4
+		params       glx.Vec4
+		shadowOffset glx.Vec4
+		gradient     shaderGradient
+	}
+
+	shaderInputSDF2dVertex struct {
+		pos   glx.Vec2
+		color glx.Vec4
+		local glx.Vec2
+	}
+)
+
+func (d *shaderInputSDF2d) VertexData() []byte {
+	const vertSize = glx.SizeOfVec2 + glx.SizeOfVec4 + glx.SizeOfVec2
+	buff := make([]byte, 0, stdShaderSDF.InputLayout.VertexCount*vertSize)
+
+	for _, vertex := range d.vertexes {
+		buff = append(buff, vertex.pos.Data()...)
+		buff = append(buff, vertex.color.Data()...)
+		buff = append(buff, vertex.local.Data()...)
+	}
+
+	return buff
+}
+
+func (d *shaderInputSDF2d) StorageData() []byte {
+	gradient := d.gradient.Data()
+	buff := make([]byte, 0, glx.SizeOfVec4*6+len(gradient))
+
+	// 96 + 192 (all fields is vec4, so struct is aligned without padding)
+	buff = append(buff, d.outlineColor.Data()...)
+	buff = append(buff, d.shadowColor.Data()...)
+	buff = append(buff, d.size.Data()...)
+	buff = append(buff, d.radius.Data()...)
+	buff = append(buff, d.params.Data()...)
+	buff = append(buff, d.shadowOffset.Data()...)
+	buff = append(buff, gradient...)
+
+	return buff
+}
+
+func (d *shaderInputSDF2d) transform(t *transform2d) {
+	for i := range d.vertexes {
+		d.vertexes[i].pos = t.apply(d.vertexes[i].pos)
+	}
+
+	d.gradient.transform(t)
+}
@@ -0,0 +1,85 @@
+package vgl
+
+import (
+	"github.com/vulkan-go/vulkan"
+
+	"github.com/go-glx/glx"
+	"github.com/go-glx/vgl/internal/shaders"
+)
+
+var (
+	stdShaderTexture = ParamsRegisterShader{
+		ShaderName:       buildInShaderTexture,
+		ProgramVert:      shaders.Texture2DVertSpv(),
+		ProgramFrag:      shaders.Texture2DFragSpv(),
+		Topology:         vulkan.PrimitiveTopologyTriangleList,
+		TopologyRestarts: false,
+		InputLayout: ParamsRegisterShaderInputLayout{
+			VertexCount: 4,
+			VertexBinding: []ParamsRegisterShaderInputVertexBinding{
+				{
+					// x, y
+					Location: 0,
+					Size:     glx.SizeOfVec2,
+					Format:   vulkan.FormatR32g32Sfloat,
+				},
+				{
+					// tint r, g, b, a
+					Location: 1,
+					Size:     glx.SizeOfVec4,
+					Format:   vulkan.FormatR32g32b32a32Sfloat,
+				},
+				{
+					// texture u, v
+					Location: 2,
+					Size:     glx.SizeOfVec2,
+					Format:   vulkan.FormatR32g32Sfloat,
+				},
+				{
+					// vertex alpha
+					Location: 3,
+					Size:     glx.SizeOfVec1,
+					Format:   vulkan.FormatR32Sfloat,
+				},
+			},
+			Indexes: []uint16{0, 1, 2, 2, 3, 0},
+		},
+	}
+)
+
+type (
+	shaderInputTexture2d struct {
+		vertexes []shaderInputTexture2dVertex
+	}
+
+	shaderInputTexture2dVertex struct {
+		pos   glx.Vec2
+		color glx.Vec4
+		uv    glx.Vec2
+		alpha glx.Vec1
+	}
+)
+
+func (d *shaderInputTexture2d) VertexData() []byte {
+	const vertSize = glx.SizeOfVec2 + glx.SizeOfVec4 + glx.SizeOfVec2 + glx.SizeOfVec1
+	buff := make([]byte, 0, stdShaderTexture.InputLayout.VertexCount*vertSize)
+
+	for _, vertex := range d.vertexes {
+		buff = append(buff, vertex.pos.Data()...)
+		buff = append(buff, vertex.color.Data()...)
+		buff = append(buff, vertex.uv.Data()...)
+		buff = append(buff, vertex.alpha.Data()...)
+	}
+
+	return buff
+}
+
+func (d *shaderInputTexture2d) StorageData() []byte {
+	return nil
+}
+
+func (d *shaderInputTexture2d) transform(t *transform2d) {
+	for i := range d.vertexes {
+		d.vertexes[i].pos = t.apply(d.vertexes[i].pos)
+	}
+}
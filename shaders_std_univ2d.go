@@ -74,6 +74,23 @@ var (
 			Indexes:       []uint16{0, 1, 2, 3, 0, 0xffff},
 		},
 	}
+
+	// outline is part of open line strip, long strips split
+	// into chunks of outlineChunkSize vertexes, shorter chunks
+	// padded by repeating last vertex (zero length segments
+	// not produce any fragments)
+	stdShaderOutline = ParamsRegisterShader{
+		ShaderName:       buildInShaderOutline,
+		ProgramVert:      shaders.Universal2DVertSpv(),
+		ProgramFrag:      shaders.Universal2DFragSpv(),
+		Topology:         vulkan.PrimitiveTopologyLineStrip,
+		TopologyRestarts: true,
+		InputLayout: ParamsRegisterShaderInputLayout{
+			VertexCount:   outlineChunkSize,
+			VertexBinding: universal2dBindings,
+			Indexes:       []uint16{0, 1, 2, 3, 4, 5, 6, 7, 0xffff},
+		},
+	}
 )
 
 type (
@@ -102,3 +119,9 @@ func (d *shaderInputUniversal2d) VertexData() []byte {
 func (d *shaderInputUniversal2d) StorageData() []byte {
 	return nil
 }
+
+func (d *shaderInputUniversal2d) transform(t *transform2d) {
+	for i := range d.vertexes {
+		d.vertexes[i].pos = t.apply(d.vertexes[i].pos)
+	}
+}
@@ -6,11 +6,18 @@ type (
 	Config struct {
 		debug  bool
 		gpu    configSwapChain
+		cache  configCache
 		logger vlkext.Logger
 	}
 
 	configSwapChain struct {
 		mobileFriendly bool
+		headless       bool
+		windowCapture  bool
+	}
+
+	configCache struct {
+		pipelineFile string
 	}
 
 	Configure = func(*Config)
@@ -49,6 +56,45 @@ func WithMobileFriendly(enabled bool) Configure {
 	}
 }
 
+// WithHeadless will render frames without window surface and
+// swap chain. Frames is drawn into own off-screen device images,
+// that never presented on screen. Useful for tests, CI and
+// software vulkan implementations (like lavapipe)
+//
+// Should be used with window manager, that can work without
+// real window (see arch/headless)
+func WithHeadless(enabled bool) Configure {
+	return func(config *Config) {
+		config.gpu.headless = enabled
+	}
+}
+
+// WithWindowCapture will copy every rendered window frame into
+// host memory before it is presented. Its required for reading
+// main surface (Render.Screenshot) in window mode, because
+// presented images is owned by presentation engine
+//
+// Copy cost some GPU time and memory every frame, so
+// it disabled by default. Not needed in headless mode
+func WithWindowCapture(enabled bool) Configure {
+	return func(config *Config) {
+		config.gpu.windowCapture = enabled
+	}
+}
+
+// WithPipelineCacheFile will load compiled GPU pipelines from file
+// on start, and save them back on Render.Close. This speed up
+// application cold start, because same pipelines not need
+// to be compiled again on every launch
+//
+// File created for other GPU (or driver version) is ignored
+// empty path (default) - cache is not persisted
+func WithPipelineCacheFile(path string) Configure {
+	return func(config *Config) {
+		config.cache.pipelineFile = path
+	}
+}
+
 // WithLogger allow to use custom logger
 // for library messages. If not set, default go
 // log.* package will be used for logging
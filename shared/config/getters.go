@@ -10,6 +10,18 @@ func (c *Config) IsMobileFriendly() bool {
 	return c.gpu.mobileFriendly
 }
 
+func (c *Config) IsHeadless() bool {
+	return c.gpu.headless
+}
+
+func (c *Config) IsWindowCapture() bool {
+	return c.gpu.windowCapture
+}
+
+func (c *Config) PipelineCacheFile() string {
+	return c.cache.pipelineFile
+}
+
 func (c *Config) Logger() vlkext.Logger {
 	return c.logger
 }
@@ -10,6 +10,7 @@ type (
 
 		DrawCalls  int
 		DrawGroups int
+		Culled     int // instances skipped on CPU side, because they not visible on surface
 
 		SegmentDuration map[string]time.Duration
 		Memory          MemoryStats
@@ -41,6 +42,7 @@ func NewStats() Stats {
 func (s *Stats) Reset() {
 	s.DrawCalls = 0
 	s.DrawGroups = 0
+	s.Culled = 0
 
 	for segment := range s.SegmentDuration {
 		s.SegmentDuration[segment] = 0
@@ -13,5 +13,6 @@ const (
 	SegmentPlBindIndexes         Segment = "pl.bind.ind"
 	SegmentPlBindVertex          Segment = "pl.bind.vert"
 	SegmentPlBindUniforms        Segment = "pl.bind.uniform"
+	SegmentPlBindTexture         Segment = "pl.bind.texture"
 	SegmentPlDraw                Segment = "pl.draw"
 )
@@ -0,0 +1,530 @@
+package vgl
+
+import (
+	"math"
+
+	"github.com/go-glx/glx"
+)
+
+const (
+	LineJoinMiter LineJoin = iota // sharp corner, replaced with bevel when longer than miter limit
+	LineJoinRound                 // rounded corner
+	LineJoinBevel                 // flat cut corner
+)
+
+const (
+	LineCapButt   LineCap = iota // line ends exactly at end point
+	LineCapSquare                // line extended by half of width after end point
+	LineCapRound                 // half circle after end point
+)
+
+const (
+	strokeDefaultMiterLimit = 4
+	strokeRoundMaxError     = 0.25 // px, max distance from ideal arc to tessellated one
+	strokeRoundMaxSegments  = 64
+)
+
+type (
+	// LineJoin define how polyline segments are connected in corners
+	LineJoin uint8
+
+	// LineCap define how open polyline ends are drawn
+	LineCap uint8
+
+	strokeStyle struct {
+		width      float32
+		join       LineJoin
+		cap        LineCap
+		miterLimit float32
+		closed     bool
+		dash       []float32
+		dashOffset float32
+	}
+
+	strokeTriangle = [3]shaderInputUniversal2dVertex
+)
+
+// strokePolyline tessellate polyline into triangles. colors must have
+// same length as points. All result triangles is in screen clock-wise order
+func strokePolyline(style strokeStyle, points []glx.Vec2, colors []glx.Vec4) []strokeTriangle {
+	if style.width <= 0 {
+		style.width = 1
+	}
+	if style.miterLimit < 1 {
+		style.miterLimit = strokeDefaultMiterLimit
+	}
+
+	path := newStrokePath(points, colors, style.closed)
+	if len(path) == 0 {
+		return nil
+	}
+
+	t := &strokeTessellator{style: style, halfWidth: style.width / 2}
+
+	for _, sub := range strokeDashes(path, style) {
+		t.stroke(sub)
+	}
+
+	return t.triangles
+}
+
+// -----------------------------------------------------------------------------
+// path
+
+type (
+	strokePoint struct {
+		pos   glx.Vec2
+		color glx.Vec4
+	}
+
+	strokeSubPath struct {
+		points []strokePoint
+		closed bool
+		dotDir glx.Vec2 // path direction at dash end (zero length dash is drawn as caps)
+	}
+)
+
+// newStrokePath remove consecutive duplicate points
+// (and last point, equal to first, for closed path)
+func newStrokePath(points []glx.Vec2, colors []glx.Vec4, closed bool) []strokePoint {
+	path := make([]strokePoint, 0, len(points))
+
+	for i, pos := range points {
+		if len(path) > 0 && path[len(path)-1].pos == pos {
+			continue
+		}
+
+		path = append(path, strokePoint{pos: pos, color: colors[i]})
+	}
+
+	if closed {
+		for len(path) > 1 && path[0].pos == path[len(path)-1].pos {
+			path = path[:len(path)-1]
+		}
+	}
+
+	return path
+}
+
+// strokeDashes split path into visible dashes. Without dash
+// pattern, whole path is returned as single sub path
+func strokeDashes(path []strokePoint, style strokeStyle) []strokeSubPath {
+	pattern, total := strokeDashPattern(style.dash)
+	if total == 0 || len(path) < 2 {
+		return []strokeSubPath{{points: path, closed: style.closed}}
+	}
+
+	if style.closed {
+		path = append(path[:len(path):len(path)], path[0])
+	}
+
+	// find dash index and distance left in it at path start
+	phase := float32(math.Mod(float64(style.dashOffset), float64(total)))
+	if phase < 0 {
+		phase += total
+	}
+
+	// zero length dash in path start is kept (drawn as caps)
+	dashInd := 0
+	for phase > 0 && phase >= pattern[dashInd] {
+		phase -= pattern[dashInd]
+		dashInd = (dashInd + 1) % len(pattern)
+	}
+
+	dashLeft := pattern[dashInd] - phase
+	visible := dashInd%2 == 0
+	startVisible := visible
+
+	dashes := make([]strokeSubPath, 0)
+	current := make([]strokePoint, 0)
+	if visible {
+		current = strokeAppend(current, path[0])
+	}
+
+	for i := 0; i < len(path)-1; i++ {
+		from, to := path[i], path[i+1]
+		segLen := strokeDistance(from.pos, to.pos)
+		passed := float32(0)
+
+		for segLen-passed > dashLeft {
+			passed += dashLeft
+			split := strokeLerp(from, to, passed/segLen)
+
+			if visible {
+				current = strokeAppend(current, split)
+				dashes = append(dashes, strokeSubPath{points: current, dotDir: to.pos.Sub(from.pos)})
+				current = make([]strokePoint, 0)
+			} else {
+				current = strokeAppend(current, split)
+			}
+
+			dashInd = (dashInd + 1) % len(pattern)
+			dashLeft = pattern[dashInd]
+			visible = !visible
+		}
+
+		dashLeft -= segLen - passed
+		if visible {
+			current = strokeAppend(current, to)
+		}
+	}
+
+	if visible && len(current) > 1 {
+		if style.closed && startVisible && len(dashes) == 0 {
+			// dash longer than whole path
+			return []strokeSubPath{{points: current[:len(current)-1], closed: true}}
+		}
+
+		if style.closed && startVisible {
+			// dash cross start point of closed path, so
+			// join its parts from path end and path start
+			dashes[0].points = append(current, dashes[0].points[1:]...)
+			return dashes
+		}
+
+		dashes = append(dashes, strokeSubPath{points: current})
+	}
+
+	return dashes
+}
+
+// strokeDashPattern return normalized dash pattern (odd count of
+// values is repeated twice) and pattern total length. Zero total
+// length mean solid line
+func strokeDashPattern(dash []float32) ([]float32, float32) {
+	if len(dash) == 0 {
+		return nil, 0
+	}
+
+	total := float32(0)
+	for _, length := range dash {
+		if length < 0 {
+			return nil, 0
+		}
+
+		total += length
+	}
+
+	if total == 0 {
+		return nil, 0
+	}
+
+	if len(dash)%2 == 1 {
+		pattern := make([]float32, 0, len(dash)*2)
+		pattern = append(pattern, dash...)
+		pattern = append(pattern, dash...)
+
+		return pattern, total * 2
+	}
+
+	return dash, total
+}
+
+// strokeAppend add point to dash, when it not equal to last dash
+// point (zero length dash or split exactly in path point)
+func strokeAppend(points []strokePoint, p strokePoint) []strokePoint {
+	if len(points) > 0 && points[len(points)-1].pos == p.pos {
+		return points
+	}
+
+	return append(points, p)
+}
+
+func strokeLerp(a, b strokePoint, t float32) strokePoint {
+	return strokePoint{
+		pos: a.pos.Add(b.pos.Sub(a.pos).Scale(t)),
+		color: glx.Vec4{
+			X: a.color.X + (b.color.X-a.color.X)*t,
+			Y: a.color.Y + (b.color.Y-a.color.Y)*t,
+			Z: a.color.Z + (b.color.Z-a.color.Z)*t,
+			W: a.color.W + (b.color.W-a.color.W)*t,
+		},
+	}
+}
+
+func strokeDistance(a, b glx.Vec2) float32 {
+	return float32(math.Hypot(float64(b.X-a.X), float64(b.Y-a.Y)))
+}
+
+// -----------------------------------------------------------------------------
+// tessellation
+
+type strokeTessellator struct {
+	style     strokeStyle
+	halfWidth float32
+	triangles []strokeTriangle
+}
+
+func (t *strokeTessellator) stroke(sub strokeSubPath) {
+	path, closed := sub.points, sub.closed
+
+	if len(path) == 1 && sub.dotDir != (glx.Vec2{}) {
+		t.dot(path[0], sub.dotDir)
+		return
+	}
+	if len(path) < 2 {
+		return
+	}
+	if len(path) < 3 {
+		closed = false
+	}
+
+	segments := len(path) - 1
+	if closed {
+		segments = len(path)
+	}
+
+	// segments is cut on inner side of corners, so they not
+	// overlap (overlap is visible with translucent colors)
+	inner := make([]*glx.Vec2, len(path))
+	for i := 0; i < len(path); i++ {
+		if !closed && (i == 0 || i == len(path)-1) {
+			continue
+		}
+
+		prev := path[(i+len(path)-1)%len(path)]
+		next := path[(i+1)%len(path)]
+		inner[i] = t.innerCorner(prev.pos, path[i].pos, next.pos)
+	}
+
+	for i := 0; i < segments; i++ {
+		next := (i + 1) % len(path)
+		t.segment(path[i], path[next], inner[i], inner[next])
+	}
+
+	for i := 0; i < len(path); i++ {
+		if !closed && (i == 0 || i == len(path)-1) {
+			continue
+		}
+
+		prev := path[(i+len(path)-1)%len(path)]
+		next := path[(i+1)%len(path)]
+		t.joint(prev.pos, path[i], next.pos, inner[i])
+	}
+
+	if !closed {
+		t.capEnd(path[1].pos, path[0])
+		t.capEnd(path[len(path)-2].pos, path[len(path)-1])
+	}
+}
+
+// segment draw quad of segment, where fromInner and toInner is
+// inner corner points in segment ends (nil - end is not cut)
+func (t *strokeTessellator) segment(from, to strokePoint, fromInner, toInner *glx.Vec2) {
+	n := strokeNormal(from.pos, to.pos).Scale(t.halfWidth)
+
+	fl, fr := strokeCut(from.pos.Add(n), from.pos.Sub(n), from.pos, n, fromInner)
+	tl, tr := strokeCut(to.pos.Add(n), to.pos.Sub(n), to.pos, n, toInner)
+
+	t.emit(fl, from.color, tl, to.color, tr, to.color)
+	t.emit(tr, to.color, fr, from.color, fl, from.color)
+}
+
+// strokeCut replace segment end vertex (left or right) on
+// inner side of corner with inner corner point
+func strokeCut(l, r, center, n glx.Vec2, inner *glx.Vec2) (glx.Vec2, glx.Vec2) {
+	if inner == nil {
+		return l, r
+	}
+
+	offset := inner.Sub(center)
+	if offset.X*n.X+offset.Y*n.Y > 0 {
+		return *inner, r
+	}
+
+	return l, *inner
+}
+
+// innerCorner return point on inner side of corner in cur, where
+// edges of both segments intersect. Nil is returned for straight
+// lines, and when corner is too sharp for segments length (then
+// segments is not cut and overlap on inner side)
+func (t *strokeTessellator) innerCorner(prev, cur, next glx.Vec2) *glx.Vec2 {
+	d0 := strokeDirection(prev, cur)
+	d1 := strokeDirection(cur, next)
+
+	cross := d0.X*d1.Y - d0.Y*d1.X
+	if cross == 0 {
+		return nil // straight line or U-turn
+	}
+
+	// phi is angle between segments, inner point is on
+	// bisector, and cut segments by halfWidth / tan(phi/2)
+	dot := float64(d0.X*d1.X + d0.Y*d1.Y)
+	sinHalf := math.Sqrt(math.Max(0, (1+dot)/2))
+	cosHalf := math.Sqrt(math.Max(0, (1-dot)/2))
+	if sinHalf == 0 {
+		return nil
+	}
+
+	cut := float64(t.halfWidth) * cosHalf / sinHalf
+	maxCut := math.Min(float64(strokeDistance(prev, cur)), float64(strokeDistance(cur, next))) / 2
+	if cut > maxCut {
+		return nil
+	}
+
+	bisect := d1.Sub(d0)
+	bisectLen := math.Hypot(float64(bisect.X), float64(bisect.Y))
+	inner := cur.Add(bisect.Scale(float32(float64(t.halfWidth) / sinHalf / bisectLen)))
+
+	return &inner
+}
+
+// joint fill gap on outer side of corner in point cur. Gap
+// triangles start from inner corner point, when segments is cut
+func (t *strokeTessellator) joint(prev glx.Vec2, cur strokePoint, next glx.Vec2, inner *glx.Vec2) {
+	d0 := strokeDirection(prev, cur.pos)
+	d1 := strokeDirection(cur.pos, next)
+
+	cross := d0.X*d1.Y - d0.Y*d1.X
+	dot := d0.X*d1.X + d0.Y*d1.Y
+	if cross == 0 && dot > 0 {
+		return // straight line
+	}
+
+	// outer side is opposite to turn direction
+	side := float32(1)
+	if cross > 0 {
+		side = -1
+	}
+
+	n0 := strokeNormal(prev, cur.pos).Scale(t.halfWidth * side)
+	n1 := strokeNormal(cur.pos, next).Scale(t.halfWidth * side)
+	a, b := cur.pos.Add(n0), cur.pos.Add(n1)
+
+	origin := cur.pos
+	if inner != nil {
+		origin = *inner
+	}
+
+	switch t.style.join {
+	case LineJoinRound:
+		t.arc(cur, origin, n0, n1, d0)
+	case LineJoinMiter:
+		// miter length / width = 1 / sin(phi/2), where phi is angle between segments
+		cosPhi := -dot
+		sinHalf := float32(math.Sqrt(math.Max(0, float64(1-cosPhi)/2)))
+
+		if sinHalf > 0 && 1/sinHalf <= t.style.miterLimit {
+			bisect := n0.Add(n1)
+			bisectLen := float32(math.Hypot(float64(bisect.X), float64(bisect.Y)))
+			miter := cur.pos.Add(bisect.Scale(t.halfWidth / sinHalf / bisectLen))
+
+			t.emit(origin, cur.color, a, cur.color, miter, cur.color)
+			t.emit(origin, cur.color, miter, cur.color, b, cur.color)
+			return
+		}
+
+		t.emit(origin, cur.color, a, cur.color, b, cur.color)
+	default:
+		t.emit(origin, cur.color, a, cur.color, b, cur.color)
+	}
+}
+
+// capEnd draw cap on end point, where prev is previous path point
+func (t *strokeTessellator) capEnd(prev glx.Vec2, end strokePoint) {
+	d := strokeDirection(prev, end.pos).Scale(t.halfWidth)
+	n := strokeNormal(prev, end.pos).Scale(t.halfWidth)
+
+	switch t.style.cap {
+	case LineCapSquare:
+		l, r := end.pos.Add(n), end.pos.Sub(n)
+		el, er := l.Add(d), r.Add(d)
+
+		t.emit(l, end.color, el, end.color, er, end.color)
+		t.emit(er, end.color, r, end.color, l, end.color)
+	case LineCapRound:
+		t.arc(end, end.pos, n, n.Scale(-1), d)
+	}
+}
+
+// dot draw both caps of zero length dash in point p,
+// where dir is path direction in this point
+func (t *strokeTessellator) dot(p strokePoint, dir glx.Vec2) {
+	t.capEnd(p.pos.Sub(dir), p)
+	t.capEnd(p.pos.Add(dir), p)
+}
+
+// arc fan triangles from origin to arc around center from offset
+// a to offset b, rotating from a in direction of toward vector
+func (t *strokeTessellator) arc(center strokePoint, origin glx.Vec2, a, b, toward glx.Vec2) {
+	from := math.Atan2(float64(a.Y), float64(a.X))
+	to := math.Atan2(float64(b.Y), float64(b.X))
+
+	sweep := to - from
+	if a.X*toward.Y-a.Y*toward.X > 0 {
+		for sweep <= 0 {
+			sweep += math.Pi * 2
+		}
+	} else {
+		for sweep >= 0 {
+			sweep -= math.Pi * 2
+		}
+	}
+
+	count := t.arcSegments(math.Abs(sweep))
+	radius := float64(t.halfWidth)
+
+	prev := center.pos.Add(a)
+	for i := 1; i <= count; i++ {
+		cur := center.pos.Add(b)
+		if i < count {
+			angle := from + sweep*float64(i)/float64(count)
+			cur = center.pos.Add(glx.Vec2{
+				X: float32(math.Cos(angle) * radius),
+				Y: float32(math.Sin(angle) * radius),
+			})
+		}
+
+		t.emit(origin, center.color, prev, center.color, cur, center.color)
+		prev = cur
+	}
+}
+
+func (t *strokeTessellator) arcSegments(sweep float64) int {
+	radius := float64(t.halfWidth)
+	step := math.Pi / 2
+	if radius > strokeRoundMaxError {
+		step = 2 * math.Acos(1-strokeRoundMaxError/radius)
+	}
+
+	count := int(math.Ceil(sweep / step))
+	if count < 1 {
+		return 1
+	}
+	if count > strokeRoundMaxSegments {
+		return strokeRoundMaxSegments
+	}
+
+	return count
+}
+
+// emit add triangle in screen clock-wise order, degenerate (and NaN) triangles are skipped
+func (t *strokeTessellator) emit(a glx.Vec2, ac glx.Vec4, b glx.Vec2, bc glx.Vec4, c glx.Vec2, cc glx.Vec4) {
+	ab, ac2 := b.Sub(a), c.Sub(a)
+	cross := ab.X*ac2.Y - ab.Y*ac2.X
+
+	if cross == 0 || math.IsNaN(float64(cross)) {
+		return
+	}
+	if cross < 0 {
+		b, bc, c, cc = c, cc, b, bc
+	}
+
+	t.triangles = append(t.triangles, strokeTriangle{
+		{pos: a, color: ac},
+		{pos: b, color: bc},
+		{pos: c, color: cc},
+	})
+}
+
+// strokeDirection is unit vector from a to b
+func strokeDirection(a, b glx.Vec2) glx.Vec2 {
+	return b.Sub(a).Scale(1 / strokeDistance(a, b))
+}
+
+// strokeNormal is unit vector, perpendicular to direction from a to b
+func strokeNormal(a, b glx.Vec2) glx.Vec2 {
+	d := strokeDirection(a, b)
+	return glx.Vec2{X: -d.Y, Y: d.X}
+}
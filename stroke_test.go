@@ -0,0 +1,138 @@
+package vgl
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-glx/glx"
+)
+
+func TestStrokePolyline(t *testing.T) {
+	line := []glx.Vec2{{X: 0, Y: 0}, {X: 10, Y: 0}}
+	corner := []glx.Vec2{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 10}}
+	square := []glx.Vec2{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 10}, {X: 0, Y: 10}}
+	sharp := []glx.Vec2{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 0, Y: 1}}
+
+	tests := []struct {
+		name      string
+		points    []glx.Vec2
+		style     strokeStyle
+		triangles int
+		area      float64
+		delta     float64 // round parts are approximated
+	}{
+		{name: "empty", points: nil, style: strokeStyle{width: 2}, triangles: 0, area: 0},
+		{name: "single point", points: line[:1], style: strokeStyle{width: 2}, triangles: 0, area: 0},
+		{name: "duplicates only", points: []glx.Vec2{{X: 1, Y: 1}, {X: 1, Y: 1}}, style: strokeStyle{width: 2}, triangles: 0, area: 0},
+		{name: "default width", points: line, style: strokeStyle{}, triangles: 2, area: 10},
+		{name: "butt cap", points: line, style: strokeStyle{width: 2}, triangles: 2, area: 20},
+		{name: "square cap", points: line, style: strokeStyle{width: 2, cap: LineCapSquare}, triangles: 6, area: 24},
+		{name: "round cap", points: line, style: strokeStyle{width: 2, cap: LineCapRound}, area: 20 + math.Pi, delta: 0.6},
+		{name: "collinear points", points: []glx.Vec2{{X: 0, Y: 0}, {X: 5, Y: 0}, {X: 10, Y: 0}}, style: strokeStyle{width: 2}, triangles: 4, area: 20},
+		{name: "miter join", points: corner, style: strokeStyle{width: 2, join: LineJoinMiter}, triangles: 6, area: 40},
+		{name: "bevel join", points: corner, style: strokeStyle{width: 2, join: LineJoinBevel}, triangles: 5, area: 39.5},
+		{name: "round join", points: corner, style: strokeStyle{width: 2, join: LineJoinRound}, area: 39 + math.Pi/4, delta: 0.1},
+		{name: "miter limit fallback to bevel", points: sharp, style: strokeStyle{width: 2, join: LineJoinMiter, miterLimit: 2}, triangles: 5},
+		{name: "closed square", points: square, style: strokeStyle{width: 2, closed: true, cap: LineCapRound}, triangles: 16, area: 80},
+		{name: "closed with repeated first point", points: append(square, square[0]), style: strokeStyle{width: 2, closed: true}, triangles: 16, area: 80},
+		{name: "dashes", points: line, style: strokeStyle{width: 2, dash: []float32{2, 2}}, triangles: 6, area: 12},
+		{name: "dashes with offset", points: line, style: strokeStyle{width: 2, dash: []float32{2, 2}, dashOffset: 1}, triangles: 6, area: 10},
+		{name: "dashes with negative offset", points: line, style: strokeStyle{width: 2, dash: []float32{2, 2}, dashOffset: -3}, triangles: 6, area: 10},
+		{name: "odd dash pattern", points: line, style: strokeStyle{width: 2, dash: []float32{3}}, triangles: 4, area: 12},
+		{name: "invalid dash pattern is solid", points: line, style: strokeStyle{width: 2, dash: []float32{2, -1}}, triangles: 2, area: 20},
+		{name: "dash longer than closed path", points: square, style: strokeStyle{width: 2, closed: true, dash: []float32{100, 1}}, triangles: 16, area: 80},
+		{name: "zero length dashes with round cap", points: line, style: strokeStyle{width: 2, cap: LineCapRound, dash: []float32{0, 4}}, triangles: 18, area: 3 * 3 * math.Sqrt(3) / 2}, // 3 hexagons (round cap with radius 1)
+		{name: "zero length dashes with square cap", points: line, style: strokeStyle{width: 2, cap: LineCapSquare, dash: []float32{0, 4}}, triangles: 12, area: 12},
+		{name: "dashes on corner", points: corner, style: strokeStyle{width: 2, join: LineJoinBevel, dash: []float32{15, 5}}, triangles: 5, area: 29.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			colors := make([]glx.Vec4, len(tt.points))
+			got := strokePolyline(tt.style, tt.points, colors)
+
+			if tt.triangles > 0 || len(tt.points) < 2 {
+				assert.Len(t, got, tt.triangles)
+			}
+
+			area := 0.0
+			for _, tri := range got {
+				cross := strokeTriangleCross(tri)
+				assert.Greater(t, cross, 0.0, "triangle %v must be in clock-wise order", tri)
+				area += cross / 2
+			}
+
+			if tt.delta == 0 {
+				tt.delta = 0.001
+			}
+
+			if tt.area > 0 {
+				assert.InDelta(t, tt.area, area, tt.delta)
+			}
+		})
+	}
+}
+
+func TestStrokeZeroLengthDashes(t *testing.T) {
+	corner := []glx.Vec2{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 10}}
+	colors := make([]glx.Vec4, len(corner))
+
+	for _, lineCap := range []LineCap{LineCapButt, LineCapSquare, LineCapRound} {
+		got := strokePolyline(strokeStyle{width: 2, cap: lineCap, dash: []float32{0, 5, 3, 2}}, corner, colors)
+
+		for _, tri := range got {
+			for _, v := range tri {
+				assert.False(t, math.IsNaN(float64(v.pos.X)) || math.IsNaN(float64(v.pos.Y)), "cap %d: NaN vertex in %v", lineCap, tri)
+			}
+		}
+	}
+
+	// butt cap of zero length dash have no area
+	got := strokePolyline(strokeStyle{width: 2, dash: []float32{0, 4}}, corner[:2], colors[:2])
+	assert.Len(t, got, 0)
+}
+
+func TestStrokeDashes(t *testing.T) {
+	white := glx.Vec4{X: 1, Y: 1, Z: 1, W: 1}
+	path := []strokePoint{
+		{pos: glx.Vec2{X: 0, Y: 0}},
+		{pos: glx.Vec2{X: 10, Y: 0}, color: white},
+	}
+
+	dashes := strokeDashes(path, strokeStyle{dash: []float32{4, 2}})
+	assert.Len(t, dashes, 2)
+
+	// first dash 0..4, color interpolated
+	assert.Equal(t, glx.Vec2{X: 0, Y: 0}, dashes[0].points[0].pos)
+	assert.Equal(t, glx.Vec2{X: 4, Y: 0}, dashes[0].points[1].pos)
+	assert.InDelta(t, 0.4, dashes[0].points[1].color.X, 0.0001)
+
+	// second dash 6..10
+	assert.Equal(t, glx.Vec2{X: 6, Y: 0}, dashes[1].points[0].pos)
+	assert.Equal(t, glx.Vec2{X: 10, Y: 0}, dashes[1].points[1].pos)
+
+	// closed path, dash cross start point is joined
+	square := []strokePoint{
+		{pos: glx.Vec2{X: 0, Y: 0}},
+		{pos: glx.Vec2{X: 10, Y: 0}},
+		{pos: glx.Vec2{X: 10, Y: 10}},
+		{pos: glx.Vec2{X: 0, Y: 10}},
+	}
+
+	dashes = strokeDashes(square, strokeStyle{closed: true, dash: []float32{5, 5}, dashOffset: 2})
+	assert.Len(t, dashes, 4)
+
+	seam := dashes[0].points
+	assert.Equal(t, glx.Vec2{X: 0, Y: 2}, seam[0].pos)
+	assert.Equal(t, glx.Vec2{X: 0, Y: 0}, seam[1].pos)
+	assert.Equal(t, glx.Vec2{X: 3, Y: 0}, seam[2].pos)
+}
+
+func strokeTriangleCross(tri strokeTriangle) float64 {
+	ab := tri[1].pos.Sub(tri[0].pos)
+	ac := tri[2].pos.Sub(tri[0].pos)
+
+	return float64(ab.X)*float64(ac.Y) - float64(ab.Y)*float64(ac.X)
+}
@@ -0,0 +1,165 @@
+package vgl
+
+import (
+	"strings"
+
+	"github.com/go-glx/glx"
+)
+
+type (
+	textLayoutParams struct {
+		size       float32 // px, font native size if zero
+		maxWidth   float32 // px, no wrapping if zero
+		lineHeight float32 // line height multiplier, 1 if zero
+		align      TextAlign
+	}
+
+	textLayout struct {
+		quads  []textQuad
+		width  float32 // widest line width
+		height float32 // all lines height
+	}
+
+	textQuad struct {
+		pos  [4]glx.Vec2 // tl, tr, br, bl relative to text top-left corner
+		uv   [2]glx.Vec2 // tl, br
+		page int
+	}
+)
+
+// layoutText split text into lines (by new line and word wrapping)
+// and place glyph quads for every visible char
+func layoutText(font *Font, text string, p textLayoutParams) textLayout {
+	scale := float32(1)
+	if p.size > 0 && font.size > 0 {
+		scale = p.size / font.size
+	}
+
+	lineHeight := p.lineHeight
+	if lineHeight <= 0 {
+		lineHeight = 1
+	}
+
+	lineStep := font.lineHeight * scale * lineHeight
+
+	lines := make([]string, 0, 1)
+	for _, paragraph := range strings.Split(strings.ReplaceAll(text, "\r", ""), "\n") {
+		lines = append(lines, wrapTextLine(font, paragraph, scale, p.maxWidth)...)
+	}
+
+	layout := textLayout{
+		quads:  make([]textQuad, 0, len(text)),
+		height: lineStep * float32(len(lines)),
+	}
+
+	widths := make([]float32, len(lines))
+	for i, line := range lines {
+		widths[i] = measureTextLine(font, line, scale)
+		if widths[i] > layout.width {
+			layout.width = widths[i]
+		}
+	}
+
+	boxWidth := layout.width
+	if p.maxWidth > 0 {
+		boxWidth = p.maxWidth
+	}
+
+	for i, line := range lines {
+		penX := float32(0)
+		switch p.align {
+		case TextAlignCenter:
+			penX = (boxWidth - widths[i]) / 2
+		case TextAlignRight:
+			penX = boxWidth - widths[i]
+		}
+
+		penY := lineStep * float32(i)
+		prev := rune(-1)
+
+		for _, char := range line {
+			glyph, ok := font.glyph(char)
+			if !ok {
+				continue
+			}
+
+			penX += font.kerning[fontKerningPair{first: prev, second: char}] * scale
+			prev = char
+
+			if glyph.width > 0 && glyph.height > 0 {
+				x := penX + glyph.xOffset*scale
+				y := penY + glyph.yOffset*scale
+				w := glyph.width * scale
+				h := glyph.height * scale
+
+				layout.quads = append(layout.quads, textQuad{
+					pos: [4]glx.Vec2{
+						{X: x, Y: y},
+						{X: x + w, Y: y},
+						{X: x + w, Y: y + h},
+						{X: x, Y: y + h},
+					},
+					uv: [2]glx.Vec2{
+						{X: glyph.x / font.pageW, Y: glyph.y / font.pageH},
+						{X: (glyph.x + glyph.atlasW) / font.pageW, Y: (glyph.y + glyph.atlasH) / font.pageH},
+					},
+					page: glyph.page,
+				})
+			}
+
+			penX += glyph.xAdvance * scale
+		}
+	}
+
+	return layout
+}
+
+// wrapTextLine split single line by words, so every line
+// fit into maxWidth. Word longer than maxWidth is not split
+func wrapTextLine(font *Font, line string, scale float32, maxWidth float32) []string {
+	if maxWidth <= 0 || measureTextLine(font, line, scale) <= maxWidth {
+		return []string{line}
+	}
+
+	lines := make([]string, 0, 2)
+	current := ""
+	started := false
+
+	for _, word := range strings.Split(line, " ") {
+		if !started {
+			current = word
+			started = word != ""
+			continue
+		}
+
+		candidate := current + " " + word
+		if word != "" && measureTextLine(font, candidate, scale) > maxWidth {
+			lines = append(lines, strings.TrimRight(current, " "))
+			current = word
+			continue
+		}
+
+		current = candidate
+	}
+
+	return append(lines, strings.TrimRight(current, " "))
+}
+
+// measureTextLine returns line advance width (without trailing spaces)
+func measureTextLine(font *Font, line string, scale float32) float32 {
+	width := float32(0)
+	prev := rune(-1)
+
+	for _, char := range strings.TrimRight(line, " ") {
+		glyph, ok := font.glyph(char)
+		if !ok {
+			continue
+		}
+
+		width += font.kerning[fontKerningPair{first: prev, second: char}] * scale
+		width += glyph.xAdvance * scale
+		prev = char
+	}
+
+	return width
+}
@@ -0,0 +1,110 @@
+package vgl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestFont returns monospace font: every glyph is 8x10 with 10px advance,
+// space advance is 5px, and kerning pair "AV" = -2px
+func newTestFont() *Font {
+	desc := &bmFont{size: 10, lineHeight: 12, base: 10, scaleW: 100, scaleH: 100, pages: []string{"0.png", "1.png"}}
+	for i, char := range "ABCVWж?" {
+		page := 0
+		if char == 'ж' {
+			page = 1
+		}
+
+		desc.chars = append(desc.chars, bmFontChar{id: char, x: float32(i * 10), width: 8, height: 10, xAdvance: 10, page: page})
+	}
+
+	desc.chars = append(desc.chars, bmFontChar{id: ' ', xAdvance: 5})
+	desc.kernings = append(desc.kernings, bmFontKerning{first: 'A', second: 'V', amount: -2})
+
+	return newFont(desc)
+}
+
+func TestLayoutText_Measure(t *testing.T) {
+	font := newTestFont()
+
+	tests := []struct {
+		name   string
+		text   string
+		params textLayoutParams
+		width  float32
+		height float32
+		quads  int
+	}{
+		{name: "empty", text: "", width: 0, height: 12, quads: 0},
+		{name: "single line", text: "ABC", width: 30, height: 12, quads: 3},
+		{name: "kerning", text: "AV", width: 18, height: 12, quads: 2},
+		{name: "space", text: "A B", width: 25, height: 12, quads: 2},
+		{name: "trailing spaces not measured", text: "AB  ", width: 20, height: 12, quads: 2},
+		{name: "scaled", text: "ABC", params: textLayoutParams{size: 20}, width: 60, height: 24, quads: 3},
+		{name: "line height", text: "A\nB", params: textLayoutParams{lineHeight: 1.5}, width: 10, height: 36, quads: 2},
+		{name: "multi line utf-8", text: "жж\r\nABC\n", width: 30, height: 36, quads: 5},
+		{name: "missing glyph fallback", text: "Aы", width: 20, height: 12, quads: 2},
+		{name: "wrap words", text: "AB AB AB", params: textLayoutParams{maxWidth: 50}, width: 45, height: 24, quads: 6},
+		{name: "long word not split", text: "ABCABC A", params: textLayoutParams{maxWidth: 20}, width: 60, height: 24, quads: 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			layout := layoutText(font, tt.text, tt.params)
+
+			assert.InDelta(t, tt.width, layout.width, 0.0001)
+			assert.InDelta(t, tt.height, layout.height, 0.0001)
+			assert.Len(t, layout.quads, tt.quads)
+		})
+	}
+}
+
+func TestLayoutText_Align(t *testing.T) {
+	font := newTestFont()
+
+	tests := []struct {
+		name  string
+		align TextAlign
+		max   float32
+		lineX [2]float32 // x of first glyph in first and second lines
+	}{
+		{name: "left", align: TextAlignLeft, lineX: [2]float32{0, 0}},
+		{name: "center", align: TextAlignCenter, lineX: [2]float32{0, 10}},
+		{name: "right", align: TextAlignRight, lineX: [2]float32{0, 20}},
+		{name: "center in max width", align: TextAlignCenter, max: 50, lineX: [2]float32{10, 20}},
+		{name: "right in max width", align: TextAlignRight, max: 50, lineX: [2]float32{20, 40}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			layout := layoutText(font, "ABC\nW", textLayoutParams{align: tt.align, maxWidth: tt.max})
+
+			assert.Len(t, layout.quads, 4)
+			assert.InDelta(t, tt.lineX[0], layout.quads[0].pos[0].X, 0.0001)
+			assert.InDelta(t, tt.lineX[1], layout.quads[3].pos[0].X, 0.0001)
+			assert.InDelta(t, 12, layout.quads[3].pos[0].Y, 0.0001)
+		})
+	}
+}
+
+func TestLayoutText_Quads(t *testing.T) {
+	font := newTestFont()
+	layout := layoutText(font, "Bж", textLayoutParams{size: 20})
+
+	assert.Len(t, layout.quads, 2)
+
+	// B glyph (second in atlas) scaled x2
+	b := layout.quads[0]
+	assert.Equal(t, 0, b.page)
+	assert.InDelta(t, 16, b.pos[1].X, 0.0001)
+	assert.InDelta(t, 20, b.pos[2].Y, 0.0001)
+	assert.InDelta(t, 0.1, b.uv[0].X, 0.0001)
+	assert.InDelta(t, 0.18, b.uv[1].X, 0.0001)
+	assert.InDelta(t, 0.1, b.uv[1].Y, 0.0001)
+
+	// ж from second page after B advance
+	zh := layout.quads[1]
+	assert.Equal(t, 1, zh.page)
+	assert.InDelta(t, 20, zh.pos[0].X, 0.0001)
+}
@@ -0,0 +1,117 @@
+package vgl
+
+import (
+	"math"
+
+	"github.com/go-glx/glx"
+)
+
+// Transform2D transform local pixel space of next drawings
+// (see Render.PushTransform) into world pixel space
+//
+// By default (zero value) transform is not change anything
+//
+//	world = Position + rotate(Rotation) * scale(Scale) * (local - Origin)
+type Transform2D struct {
+	Position glx.Vec2  // world position of local Origin
+	Rotation glx.Angle // rotation in radians (clock-wise) around Origin
+	Scale    glx.Vec2  // default=1,1; scale around Origin (zero component is 1)
+	Origin   glx.Vec2  // local pivot point for rotation and scale
+}
+
+// transform2d is affine 2d matrix:
+//
+//	x' = a*x + c*y + tx
+//	y' = b*x + d*y + ty
+type transform2d struct {
+	a, b, c, d float32
+	tx, ty     float32
+}
+
+var transform2dIdentity = transform2d{a: 1, d: 1}
+
+func (t Transform2D) matrix() transform2d {
+	scale := t.Scale
+	if scale.X == 0 {
+		scale.X = 1
+	}
+	if scale.Y == 0 {
+		scale.Y = 1
+	}
+
+	sin, cos := math.Sincos(float64(t.Rotation))
+
+	m := transform2d{
+		a: float32(cos) * scale.X,
+		b: float32(sin) * scale.X,
+		c: -float32(sin) * scale.Y,
+		d: float32(cos) * scale.Y,
+	}
+
+	m.tx = t.Position.X - (m.a*t.Origin.X + m.c*t.Origin.Y)
+	m.ty = t.Position.Y - (m.b*t.Origin.X + m.d*t.Origin.Y)
+
+	return m
+}
+
+func (t *transform2d) isIdentity() bool {
+	return *t == transform2dIdentity
+}
+
+func (t *transform2d) apply(pos glx.Vec2) glx.Vec2 {
+	return glx.Vec2{
+		X: t.a*pos.X + t.c*pos.Y + t.tx,
+		Y: t.b*pos.X + t.d*pos.Y + t.ty,
+	}
+}
+
+// applyVector transform direction/offset (without translation)
+func (t *transform2d) applyVector(vec glx.Vec2) glx.Vec2 {
+	return glx.Vec2{
+		X: t.a*vec.X + t.c*vec.Y,
+		Y: t.b*vec.X + t.d*vec.Y,
+	}
+}
+
+// mul returns transform, that apply n first, and then t
+func (t *transform2d) mul(n transform2d) transform2d {
+	return transform2d{
+		a:  t.a*n.a + t.c*n.b,
+		b:  t.b*n.a + t.d*n.b,
+		c:  t.a*n.c + t.c*n.d,
+		d:  t.b*n.c + t.d*n.d,
+		tx: t.a*n.tx + t.c*n.ty + t.tx,
+		ty: t.b*n.tx + t.d*n.ty + t.ty,
+	}
+}
+
+// scale returns max scale factor of transform axes
+func (t *transform2d) scale() float32 {
+	sx := math.Hypot(float64(t.a), float64(t.b))
+	sy := math.Hypot(float64(t.c), float64(t.d))
+
+	return float32(math.Max(sx, sy))
+}
+
+// rotation returns rotation of transform x axis
+func (t *transform2d) rotation() float64 {
+	return math.Atan2(float64(t.b), float64(t.a))
+}
+
+// rectFromCenter returns clock-wise rect corners (tl, tr, br, bl) of rect
+// with center and size, rotated around pivot (center + origin)
+func rectFromCenter(center, size glx.Vec2, rotation glx.Angle, origin glx.Vec2) [4]glx.Vec2 {
+	half := size.Scale(0.5)
+	m := Transform2D{
+		Position: center.Add(origin),
+		Rotation: rotation,
+		Origin:   origin,
+	}.matrix()
+
+	return [4]glx.Vec2{
+		m.apply(glx.Vec2{X: -half.X, Y: -half.Y}),
+		m.apply(glx.Vec2{X: +half.X, Y: -half.Y}),
+		m.apply(glx.Vec2{X: +half.X, Y: +half.Y}),
+		m.apply(glx.Vec2{X: -half.X, Y: +half.Y}),
+	}
+}
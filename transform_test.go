@@ -0,0 +1,98 @@
+package vgl
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-glx/glx"
+)
+
+func TestTransform2D_Matrix(t *testing.T) {
+	tests := []struct {
+		name      string
+		transform Transform2D
+		in        glx.Vec2
+		out       glx.Vec2
+	}{
+		{name: "zero value is identity", transform: Transform2D{}, in: glx.Vec2{X: 3, Y: 4}, out: glx.Vec2{X: 3, Y: 4}},
+		{name: "translate", transform: Transform2D{Position: glx.Vec2{X: 10, Y: 20}}, in: glx.Vec2{X: 3, Y: 4}, out: glx.Vec2{X: 13, Y: 24}},
+		{name: "rotate clock-wise", transform: Transform2D{Rotation: math.Pi / 2}, in: glx.Vec2{X: 1}, out: glx.Vec2{Y: 1}},
+		{name: "scale one axis", transform: Transform2D{Scale: glx.Vec2{X: 2}}, in: glx.Vec2{X: 3, Y: 4}, out: glx.Vec2{X: 6, Y: 4}},
+		{
+			name:      "origin is placed at position",
+			transform: Transform2D{Position: glx.Vec2{X: 100, Y: 100}, Rotation: math.Pi, Scale: glx.Vec2{X: 2, Y: 2}, Origin: glx.Vec2{X: 5, Y: 5}},
+			in:        glx.Vec2{X: 5, Y: 6},
+			out:       glx.Vec2{X: 100, Y: 98},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := tt.transform.matrix()
+			out := m.apply(tt.in)
+
+			assert.InDelta(t, tt.out.X, out.X, 0.0001)
+			assert.InDelta(t, tt.out.Y, out.Y, 0.0001)
+		})
+	}
+
+	identity := Transform2D{}.matrix()
+	assert.True(t, identity.isIdentity())
+}
+
+func TestTransform2d_Mul(t *testing.T) {
+	// nested: panel is moved, then content inside panel is rotated and scaled
+	panel := Transform2D{Position: glx.Vec2{X: 100, Y: 50}}.matrix()
+	content := Transform2D{Rotation: math.Pi / 2, Scale: glx.Vec2{X: 3, Y: 3}}.matrix()
+	m := panel.mul(content)
+
+	out := m.apply(glx.Vec2{X: 1, Y: 0})
+	assert.InDelta(t, 100, out.X, 0.0001)
+	assert.InDelta(t, 53, out.Y, 0.0001)
+
+	assert.InDelta(t, 3, m.scale(), 0.0001)
+	assert.InDelta(t, math.Pi/2, m.rotation(), 0.0001)
+
+	vec := m.applyVector(glx.Vec2{X: 1})
+	assert.InDelta(t, 0, vec.X, 0.0001)
+	assert.InDelta(t, 3, vec.Y, 0.0001)
+}
+
+func TestRectFromCenter(t *testing.T) {
+	tests := []struct {
+		name     string
+		rotation glx.Angle
+		origin   glx.Vec2
+		tl, br   glx.Vec2
+	}{
+		{name: "not rotated", tl: glx.Vec2{X: 40, Y: 45}, br: glx.Vec2{X: 60, Y: 55}},
+		{name: "rotated around center", rotation: math.Pi / 2, tl: glx.Vec2{X: 55, Y: 40}, br: glx.Vec2{X: 45, Y: 60}},
+		{name: "rotated around top-left corner", rotation: math.Pi, origin: glx.Vec2{X: -10, Y: -5}, tl: glx.Vec2{X: 40, Y: 45}, br: glx.Vec2{X: 20, Y: 35}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rect := rectFromCenter(glx.Vec2{X: 50, Y: 50}, glx.Vec2{X: 20, Y: 10}, tt.rotation, tt.origin)
+
+			assert.InDelta(t, tt.tl.X, rect[0].X, 0.0001)
+			assert.InDelta(t, tt.tl.Y, rect[0].Y, 0.0001)
+			assert.InDelta(t, tt.br.X, rect[2].X, 0.0001)
+			assert.InDelta(t, tt.br.Y, rect[2].Y, 0.0001)
+		})
+	}
+}
+
+func TestShaderInput_Transform(t *testing.T) {
+	m := Transform2D{Position: glx.Vec2{X: 10}, Scale: glx.Vec2{X: 2, Y: 2}}.matrix()
+
+	points := &shaderInputPoints2d{vertexes: []shaderInputPoints2dVertex{{pos: glx.Vec2{X: 1, Y: 1}, size: 3}}}
+	points.transform(&m)
+	assert.Equal(t, glx.Vec2{X: 12, Y: 2}, points.vertexes[0].pos)
+	assert.Equal(t, float32(6), points.vertexes[0].size)
+
+	gradient := (&Gradient{Type: GradientRadial, Stops: []GradientStop{{}}}).resolve(glx.Vec2{}, glx.Vec2{X: 2, Y: 2})
+	gradient.transform(&m)
+	assert.InDeltaSlice(t, []float32{12, 2, 2 * float32(math.Sqrt2)}, []float32{gradient.geometry.X, gradient.geometry.Y, gradient.geometry.Z}, 0.0001)
+}
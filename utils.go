@@ -1,72 +1,157 @@
 package vgl
 
 import (
+	"math"
+
 	"github.com/go-glx/glx"
+	"github.com/go-glx/vgl/internal/gpu/vlk"
 )
 
-// transform pos in pixel screen space
-// to local renderer space [ -1 .. 0 .. +1 ]
-// local space is
-//  __________________________ -> Width
-//  |          Y:-1          |
-//  |                        |
-//  | X:-1     0, 0     x:+1 |
-//  |                        |
-//  |          Y:+1          |
-//  --------------------------
-//                           ^
-//                        Height
-//
-// This is fast function, can be called thousands times
-// per frame, surface w/h is cached in renderer
-func (r *Render) toLocalSpace2d(pos glx.Vec2) glx.Vec2 {
-	w, h := r.api.GetSurfaceSize()
-
-	return glx.Vec2{
-		X: 2*(pos.X/w) - 1,
-		Y: 2*(pos.Y/h) - 1,
+// draw will send instance to GPU, after transforming
+// it with current transform (see PushTransform),
+// clipping with current clip rect (see PushClipRect)
+// and masking with current mask (see BeginMask)
+func (r *Render) draw(name string, mode vlk.DrawOptions, input shaderInput) {
+	mode.MaskMode = r.maskMode
+
+	if clip, ok := r.currentClip(); ok {
+		width, height := r.api.GetSurfaceSize()
+		scissor, visible := clipScissor(clip, width, height)
+		if !visible {
+			// nothing can be visible in empty or off-surface clip
+			r.api.CountCulled()
+			return
+		}
+
+		mode.Clip = scissor
+		mode.HasClip = true
 	}
+
+	if !r.transform.isIdentity() {
+		input.transform(&r.transform)
+	}
+
+	r.api.Draw(name, mode, input)
 }
 
-func (r *Render) toLocalAspectRation(n float32) float32 {
-	w, h := r.api.GetSurfaceSize()
+// currentCamera returns camera of current surface
+func (r *Render) currentCamera() *Camera2D {
+	return &r.cameras[r.api.CurrentSurface()]
+}
 
-	if w <= 0 || h <= 0 {
-		return 0
-	}
+// currentSurfaceBox returns visible area of current surface, limited
+// by current clip rect. Surface w/h is cached in renderer, so this
+// is fast function
+func (r *Render) currentSurfaceBox() surfaceBox {
+	box := newSurfaceBox(r.api.GetSurfaceSize())
 
-	if w > h {
-		return n / w
+	if clip, ok := r.currentClip(); ok {
+		box = box.intersect(clip)
 	}
 
-	return n / h
+	return box
 }
 
-func (r *Render) toLocalAspectRationX(n float32) float32 {
-	w, _ := r.api.GetSurfaceSize()
-	return n / w
+// localToScreen transform local position (in current transform)
+// into surface pixel position
+func (r *Render) localToScreen(pos glx.Vec2) glx.Vec2 {
+	camera := r.currentCamera()
+	return camera.worldToScreen(r.transform.apply(pos))
 }
 
-func (r *Render) toLocalAspectRationY(n float32) float32 {
-	_, h := r.api.GetSurfaceSize()
-	return n / h
+// screenScale returns how many surface pixels is in one local
+// pixel (in current transform and camera)
+func (r *Render) screenScale() float32 {
+	return r.currentCamera().zoom() * r.transform.scale()
 }
 
+// All culling functions check that local figure is visible on
+// current surface, after transformation by current transform
+// and surface camera
+//
+// This is fast functions, can be called thousands times
+// per frame. Not visible figures is counted in frame stats
+
 func (r *Render) cullingPoint(vert glx.Vec2) bool {
-	return vert.X >= -1 && vert.X <= 1 && vert.Y >= -1 && vert.Y <= 1
+	return r.countCulled(r.currentSurfaceBox().containsPoint(
+		r.localToScreen(vert),
+	))
 }
 
 func (r *Render) cullingLine(vert [2]glx.Vec2) bool {
-	// todo
-	return true
+	return r.countCulled(r.currentSurfaceBox().intersectsPolygon([]glx.Vec2{
+		r.localToScreen(vert[0]),
+		r.localToScreen(vert[1]),
+	}))
 }
 
 func (r *Render) cullingTriangle(vert [3]glx.Vec2) bool {
-	// todo
-	return true
+	return r.countCulled(r.currentSurfaceBox().intersectsPolygon([]glx.Vec2{
+		r.localToScreen(vert[0]),
+		r.localToScreen(vert[1]),
+		r.localToScreen(vert[2]),
+	}))
 }
 
 func (r *Render) cullingRect(vert [4]glx.Vec2) bool {
-	// todo
-	return true
+	return r.countCulled(r.currentSurfaceBox().intersectsPolygon([]glx.Vec2{
+		r.localToScreen(vert[0]),
+		r.localToScreen(vert[1]),
+		r.localToScreen(vert[2]),
+		r.localToScreen(vert[3]),
+	}))
+}
+
+func (r *Render) cullingPolygon(vert []glx.Vec2) bool {
+	screen := make([]glx.Vec2, 0, len(vert))
+
+	for _, v := range vert {
+		screen = append(screen, r.localToScreen(v))
+	}
+
+	return r.countCulled(r.currentSurfaceBox().intersectsPolygon(screen))
+}
+
+// cullingPolyline check bounding box of points, expanded by margin
+func (r *Render) cullingPolyline(vert []glx.Vec2, margin float32) bool {
+	min, max := pointsBounds(vert)
+
+	return r.cullingRect([4]glx.Vec2{
+		{X: min.X - margin, Y: min.Y - margin},
+		{X: max.X + margin, Y: min.Y - margin},
+		{X: max.X + margin, Y: max.Y + margin},
+		{X: min.X - margin, Y: max.Y + margin},
+	})
+}
+
+func (r *Render) cullingCircle(center glx.Vec2, radius float32) bool {
+	return r.countCulled(r.currentSurfaceBox().intersectsCircle(
+		r.localToScreen(center),
+		radius*r.screenScale(),
+	))
+}
+
+func (r *Render) countCulled(visible bool) bool {
+	if !visible {
+		r.api.CountCulled()
+	}
+
+	return visible
+}
+
+// pointsBounds returns bounding box of points
+func pointsBounds(points []glx.Vec2) (min, max glx.Vec2) {
+	if len(points) == 0 {
+		return
+	}
+
+	min, max = points[0], points[0]
+	for _, point := range points[1:] {
+		min.X = float32(math.Min(float64(min.X), float64(point.X)))
+		min.Y = float32(math.Min(float64(min.Y), float64(point.Y)))
+		max.X = float32(math.Max(float64(max.X), float64(point.X)))
+		max.Y = float32(math.Max(float64(max.Y), float64(point.Y)))
+	}
+
+	return min, max
 }
@@ -0,0 +1,60 @@
+package vgltest
+
+import (
+	"image"
+	"image/color"
+)
+
+// Compare will compare expected and actual pixels, with max allowed
+// difference of each channel. Returns count of mismatched pixels and
+// diff image, where mismatched pixels is red, and others is dimmed
+// expected image
+//
+// Images with different size is always not equal, diff image
+// is not created in this case
+func Compare(expected, actual *image.RGBA, tolerance uint8) (mismatched int, diff *image.RGBA) {
+	if expected.Rect.Size() != actual.Rect.Size() {
+		size := expected.Rect.Size()
+		if actualSize := actual.Rect.Size(); actualSize.X*actualSize.Y > size.X*size.Y {
+			size = actualSize
+		}
+
+		return size.X * size.Y, nil
+	}
+
+	size := expected.Rect.Size()
+	diff = image.NewRGBA(image.Rect(0, 0, size.X, size.Y))
+
+	for y := 0; y < size.Y; y++ {
+		for x := 0; x < size.X; x++ {
+			exp := expected.RGBAAt(expected.Rect.Min.X+x, expected.Rect.Min.Y+y)
+			act := actual.RGBAAt(actual.Rect.Min.X+x, actual.Rect.Min.Y+y)
+
+			if pixelEqual(exp, act, tolerance) {
+				gray := uint8((uint32(exp.R) + uint32(exp.G) + uint32(exp.B)) / 3 / 4)
+				diff.SetRGBA(x, y, color.RGBA{R: gray, G: gray, B: gray, A: 255})
+				continue
+			}
+
+			mismatched++
+			diff.SetRGBA(x, y, color.RGBA{R: 255, A: 255})
+		}
+	}
+
+	return mismatched, diff
+}
+
+func pixelEqual(a, b color.RGBA, tolerance uint8) bool {
+	return channelEqual(a.R, b.R, tolerance) &&
+		channelEqual(a.G, b.G, tolerance) &&
+		channelEqual(a.B, b.B, tolerance) &&
+		channelEqual(a.A, b.A, tolerance)
+}
+
+func channelEqual(a, b uint8, tolerance uint8) bool {
+	if a > b {
+		return a-b <= tolerance
+	}
+
+	return b-a <= tolerance
+}
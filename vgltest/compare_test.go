@@ -0,0 +1,108 @@
+package vgltest
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	fill := func(w, h int, col color.RGBA) *image.RGBA {
+		img := image.NewRGBA(image.Rect(0, 0, w, h))
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				img.SetRGBA(x, y, col)
+			}
+		}
+
+		return img
+	}
+
+	withPixel := func(img *image.RGBA, x, y int, col color.RGBA) *image.RGBA {
+		img.SetRGBA(x, y, col)
+		return img
+	}
+
+	base := color.RGBA{R: 100, G: 150, B: 200, A: 255}
+
+	tests := []struct {
+		name           string
+		expected       *image.RGBA
+		actual         *image.RGBA
+		tolerance      uint8
+		wantMismatched int
+		wantDiff       bool
+	}{
+		{
+			name:           "equal",
+			expected:       fill(4, 4, base),
+			actual:         fill(4, 4, base),
+			tolerance:      0,
+			wantMismatched: 0,
+			wantDiff:       true,
+		},
+		{
+			name:           "in tolerance",
+			expected:       fill(4, 4, base),
+			actual:         fill(4, 4, color.RGBA{R: 102, G: 148, B: 200, A: 254}),
+			tolerance:      2,
+			wantMismatched: 0,
+			wantDiff:       true,
+		},
+		{
+			name:           "out of tolerance",
+			expected:       fill(4, 4, base),
+			actual:         withPixel(fill(4, 4, base), 1, 2, color.RGBA{R: 103, G: 150, B: 200, A: 255}),
+			tolerance:      2,
+			wantMismatched: 1,
+			wantDiff:       true,
+		},
+		{
+			name:           "alpha mismatch",
+			expected:       fill(2, 2, base),
+			actual:         fill(2, 2, color.RGBA{R: 100, G: 150, B: 200, A: 0}),
+			tolerance:      10,
+			wantMismatched: 4,
+			wantDiff:       true,
+		},
+		{
+			name:           "different size",
+			expected:       fill(4, 4, base),
+			actual:         fill(4, 5, base),
+			tolerance:      255,
+			wantMismatched: 20,
+			wantDiff:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mismatched, diff := Compare(tt.expected, tt.actual, tt.tolerance)
+
+			assert.Equal(t, tt.wantMismatched, mismatched)
+			assert.Equal(t, tt.wantDiff, diff != nil)
+
+			if diff != nil {
+				assert.Equal(t, tt.expected.Rect.Size(), diff.Rect.Size())
+			}
+		})
+	}
+}
+
+func TestPNGRoundTrip(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 3, 1))
+	img.Pix = []byte{
+		255, 0, 0, 255, // opaque
+		10, 20, 30, 40, // premultiplied translucent
+		0, 0, 0, 0, // transparent
+	}
+
+	path := t.TempDir() + "/img.png"
+	assert.NoError(t, savePNG(path, img))
+
+	loaded, err := loadPNG(path)
+	assert.NoError(t, err)
+	assert.Equal(t, img.Pix, loaded.Pix)
+}
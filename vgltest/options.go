@@ -0,0 +1,56 @@
+package vgltest
+
+type (
+	options struct {
+		width     int
+		height    int
+		frames    int
+		tolerance uint8
+		goldenDir string
+	}
+
+	Option = func(*options)
+)
+
+func defaultOptions() options {
+	return options{
+		width:     256,
+		height:    256,
+		frames:    3,
+		tolerance: 2,
+		goldenDir: "testdata/golden",
+	}
+}
+
+// WithSize set size of headless main surface in pixels
+func WithSize(width, height int) Option {
+	return func(opts *options) {
+		opts.width = width
+		opts.height = height
+	}
+}
+
+// WithFrames set how many frames will be rendered with draw
+// function, before last frame image is compared with golden
+func WithFrames(count int) Option {
+	return func(opts *options) {
+		opts.frames = count
+	}
+}
+
+// WithTolerance set max allowed difference of each pixel
+// channel (0-255). Software and hardware GPU rasterizers
+// can produce slightly different pixels on edges
+func WithTolerance(tolerance uint8) Option {
+	return func(opts *options) {
+		opts.tolerance = tolerance
+	}
+}
+
+// WithGoldenDir set directory with golden PNG images
+// relative to test package directory
+func WithGoldenDir(dir string) Option {
+	return func(opts *options) {
+		opts.goldenDir = dir
+	}
+}
@@ -0,0 +1,67 @@
+package vgltest
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"os"
+	"path/filepath"
+)
+
+// savePNG will store raw image bytes into PNG file. Bytes is saved
+// as is (not converted from premultiplied alpha), so loaded image
+// will have exactly same bytes, as rendered by GPU
+func savePNG(path string, img *image.RGBA) error {
+	err := os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return fmt.Errorf("failed create dir for '%s': %w", path, err)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed create '%s': %w", path, err)
+	}
+
+	defer file.Close()
+
+	raw := &image.NRGBA{
+		Pix:    img.Pix,
+		Stride: img.Stride,
+		Rect:   img.Rect,
+	}
+
+	err = png.Encode(file, raw)
+	if err != nil {
+		return fmt.Errorf("failed encode '%s': %w", path, err)
+	}
+
+	return nil
+}
+
+func loadPNG(path string) (*image.RGBA, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	img, err := png.Decode(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed decode '%s': %w", path, err)
+	}
+
+	switch src := img.(type) {
+	case *image.NRGBA:
+		// raw bytes, see savePNG
+		return &image.RGBA{Pix: src.Pix, Stride: src.Stride, Rect: src.Rect}, nil
+	case *image.RGBA:
+		// opaque images is decoded without alpha conversion
+		return src, nil
+	default:
+		dst := image.NewRGBA(img.Bounds())
+		draw.Draw(dst, dst.Rect, img, img.Bounds().Min, draw.Src)
+		return dst, nil
+	}
+}
@@ -0,0 +1,185 @@
+// Package vgltest is visual regression test harness. It starts headless
+// Render on any available vulkan device (including software implementations
+// like lavapipe), draws frames and compares result with golden PNG images
+//
+// Golden images can be (re)generated with (flag is known only by
+// test packages, that import vgltest, so run it per package):
+//
+//	go test ./path/to/package -update
+//
+// On mismatch, actual and diff images is written next to golden
+// image with ".actual.png" and ".diff.png" suffixes
+//
+// Tests is skipped, when system not have any vulkan device. Set
+// VGLTEST_REQUIRE_DEVICE=1 env variable (in CI) to fail them instead
+package vgltest
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"image"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-glx/vgl"
+	"github.com/go-glx/vgl/arch/headless"
+	"github.com/go-glx/vgl/shared/config"
+)
+
+const envRequireDevice = "VGLTEST_REQUIRE_DEVICE"
+
+var update = flag.Bool("update", false, "regenerate vgltest golden images")
+
+// Harness own headless Render, that can be shared by many
+// tests (subtests), because Render creation is slow
+type Harness struct {
+	rnd  *vgl.Render
+	opts options
+}
+
+// New will create headless Render, that will be closed after test end
+// Test is skipped, when there is no suitable vulkan device in system
+func New(t testing.TB, opts ...Option) *Harness {
+	t.Helper()
+
+	options := defaultOptions()
+	for _, apply := range opts {
+		apply(&options)
+	}
+
+	rnd, err := newHeadlessRender(options)
+	if err != nil && os.Getenv(envRequireDevice) != "" {
+		t.Fatalf("vgltest: headless vulkan render not available: %v", err)
+	}
+	if err != nil {
+		t.Skipf("vgltest: headless vulkan render not available: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = rnd.Close()
+	})
+
+	return &Harness{
+		rnd:  rnd,
+		opts: options,
+	}
+}
+
+func newHeadlessRender(opts options) (*vgl.Render, error) {
+	// render panic on vulkan initialization errors, this is
+	// fine for real bugs, but system without vulkan device
+	// should skip tests instead
+	err := headless.Available()
+	if err != nil {
+		return nil, err
+	}
+
+	wm := headless.NewHeadless("vgltest", "VGL", opts.width, opts.height)
+	return vgl.NewRender(wm, config.NewConfig(
+		config.WithHeadless(true),
+		config.WithLogger(&silentLogger{}),
+	)), nil
+}
+
+// Render returns harness render, that can be used
+// for preparing resources (textures, surfaces, etc..)
+func (h *Harness) Render() *vgl.Render {
+	return h.rnd
+}
+
+// Frames will reset render state (surface, camera, blend mode), then
+// draw count frames with draw function, and return image of last frame
+func (h *Harness) Frames(count int, draw func(rnd *vgl.Render)) (*image.RGBA, error) {
+	h.resetState()
+
+	for i := 0; i < count; i++ {
+		h.rnd.FrameStart()
+		draw(h.rnd)
+		h.rnd.FrameEnd()
+	}
+
+	return h.rnd.Screenshot()
+}
+
+// AssertGolden will render frames with draw function, and compare
+// last frame with golden image "<goldenDir>/<name>.png"
+func (h *Harness) AssertGolden(t testing.TB, name string, draw func(rnd *vgl.Render)) {
+	t.Helper()
+
+	actual, err := h.Frames(h.opts.frames, draw)
+	if err != nil {
+		t.Fatalf("vgltest: failed read frame: %v", err)
+	}
+
+	AssertImage(t, filepath.Join(h.opts.goldenDir, name+".png"), actual, h.opts.tolerance)
+}
+
+// AssertImage will compare actual image with golden PNG file. When
+// test is running with -update flag, golden file is overwritten instead
+func AssertImage(t testing.TB, goldenPath string, actual *image.RGBA, tolerance uint8) {
+	t.Helper()
+
+	actualPath := replaceExt(goldenPath, ".actual.png")
+	diffPath := replaceExt(goldenPath, ".diff.png")
+
+	if *update {
+		if err := savePNG(goldenPath, actual); err != nil {
+			t.Fatalf("vgltest: failed update golden: %v", err)
+		}
+
+		_ = os.Remove(actualPath)
+		_ = os.Remove(diffPath)
+		return
+	}
+
+	expected, err := loadPNG(goldenPath)
+	if errors.Is(err, os.ErrNotExist) {
+		_ = savePNG(actualPath, actual)
+		t.Fatalf("vgltest: golden '%s' not exist (run tests with -update), actual image: %s", goldenPath, actualPath)
+	}
+	if err != nil {
+		t.Fatalf("vgltest: failed load golden: %v", err)
+	}
+
+	mismatched, diff := Compare(expected, actual, tolerance)
+	if mismatched == 0 {
+		_ = os.Remove(actualPath)
+		_ = os.Remove(diffPath)
+		return
+	}
+
+	_ = savePNG(actualPath, actual)
+	if diff != nil {
+		_ = savePNG(diffPath, diff)
+	}
+
+	t.Errorf("vgltest: %d pixels not match golden '%s' (size %s, actual %s), see: %s, %s",
+		mismatched,
+		goldenPath,
+		expected.Rect.Size(),
+		actual.Rect.Size(),
+		actualPath,
+		diffPath,
+	)
+}
+
+func (h *Harness) resetState() {
+	_ = h.rnd.SetSurface(vgl.SurfaceMain)
+	h.rnd.SetCamera2D(vgl.Camera2D{})
+	h.rnd.SetBlendMode(vgl.BlendModeDefault)
+}
+
+func replaceExt(path string, ext string) string {
+	return path[:len(path)-len(filepath.Ext(path))] + ext
+}
+
+type silentLogger struct{}
+
+func (l *silentLogger) Debug(_ string)  {}
+func (l *silentLogger) Info(_ string)   {}
+func (l *silentLogger) Notice(_ string) {}
+func (l *silentLogger) Error(msg string) {
+	fmt.Fprintf(os.Stderr, "vgltest: vk error: %s\n", msg)
+}